@@ -0,0 +1,194 @@
+package i18n
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrAssetNotFound = errors.New("asset not found")
+
+	errNoAssetResolver = errors.New("asset resolver is nil")
+	errNoAssetFS       = errors.New("asset resolver file system is nil")
+)
+
+// Asset is a localized file resolved by an AssetResolver
+type Asset struct {
+	// Path is the path of the file in the resolver file system, e.g. 'fr/terms.pdf'
+	Path string
+	// Locale is the locale of the directory containing the file, e.g. 'fr'
+	Locale string
+}
+
+// AssetResolver resolves localized files organized in locale directories like the KeyPairFSParser catalogs, e.g.
+// 'assets/fr/terms.pdf', searching the locales of the fallback chain of its catalog
+type AssetResolver struct {
+	fsys    fs.FS
+	catalog *Catalog
+
+	directories map[string]string // normalized locale -> locale directory
+
+	lock sync.RWMutex
+}
+
+// NewAssetResolver returns a new AssetResolver for the locale directories of the specified file system
+func NewAssetResolver(fsys fs.FS) *AssetResolver {
+	return &AssetResolver{
+		fsys:        fsys,
+		directories: make(map[string]string),
+	}
+}
+
+// NewAssetResolverFromDirectory returns a new AssetResolver for the locale directories of the specified directory
+func NewAssetResolverFromDirectory(directory string) *AssetResolver {
+	return NewAssetResolver(os.DirFS(directory))
+}
+
+// WithCatalog sets the catalog providing the fallback chain of locales
+func (ar *AssetResolver) WithCatalog(catalog *Catalog) *AssetResolver {
+	if ar != nil {
+		ar.catalog = catalog
+	}
+	return ar
+}
+
+// Initialize discovers the locale directories of the resolver file system
+func (ar *AssetResolver) Initialize() (*AssetResolver, error) {
+	switch {
+	case ar == nil:
+		return nil, errNoAssetResolver
+	case ar.fsys == nil:
+		return ar, errNoAssetFS
+	}
+
+	entries, err := fs.ReadDir(ar.fsys, ".")
+	if err != nil {
+		return ar, fmt.Errorf("failed to read asset directories: %w", err)
+	}
+
+	directories := make(map[string]string)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			directories[normalizeLocale(entry.Name())] = entry.Name()
+		}
+	}
+
+	ar.lock.Lock()
+	defer ar.lock.Unlock()
+
+	ar.directories = directories
+	return ar, nil
+}
+
+// Locales returns the locales of the directories of the resolver
+func (ar *AssetResolver) Locales() []string {
+	if ar == nil {
+		return []string{}
+	}
+
+	ar.lock.RLock()
+	defer ar.lock.RUnlock()
+
+	locales := make([]string, 0, len(ar.directories))
+	for _, directory := range ar.directories {
+		locales = append(locales, directory)
+	}
+	sort.Strings(locales)
+
+	return locales
+}
+
+// Resolve returns the asset of the specified name, e.g. 'terms.pdf' or 'images/logo.png', for the locale, searching
+// the locales of the catalog fallback chain, and whether it was found
+func (ar *AssetResolver) Resolve(locale string, name string) (Asset, bool) {
+	if ar == nil || !fs.ValidPath(name) || name == "." {
+		return Asset{}, false
+	}
+
+	ar.lock.RLock()
+	defer ar.lock.RUnlock()
+
+	chain := []string{locale}
+	if ar.catalog != nil {
+		chain = ar.catalog.fallbackChain(locale)
+	}
+	for _, candidate := range chain {
+		directory, exists := ar.directories[normalizeLocale(candidate)]
+		if !exists {
+			continue
+		}
+
+		filePath := path.Join(directory, name)
+		if info, err := fs.Stat(ar.fsys, filePath); err == nil && !info.IsDir() {
+			return Asset{Path: filePath, Locale: directory}, true
+		}
+	}
+	return Asset{}, false
+}
+
+// ResolveWithReader returns the asset of the specified name for the locale of the catalog reader
+func (ar *AssetResolver) ResolveWithReader(reader *CatalogReader, name string) (Asset, bool) {
+	return ar.Resolve(reader.Locale(), name)
+}
+
+// Open opens the asset of the specified name for the locale, returning an error wrapping ErrAssetNotFound when the
+// asset does not exist
+func (ar *AssetResolver) Open(locale string, name string) (fs.File, Asset, error) {
+	asset, exists := ar.Resolve(locale, name)
+	if !exists {
+		return nil, asset, fmt.Errorf("%w: '%s' for locale '%s'", ErrAssetNotFound, name, locale)
+	}
+
+	f, err := ar.fsys.Open(asset.Path)
+	if err != nil {
+		return nil, asset, fmt.Errorf("failed to open asset '%s': %w", asset.Path, err)
+	}
+	return f, asset, nil
+}
+
+// ServeHTTP serves the asset named by the request path, e.g. '/terms.pdf', for the locale of the catalog reader of
+// the request context, setting the Content-Language header to the locale of the asset and the Vary header to
+// Accept-Language; use http.StripPrefix to serve the assets under a path prefix
+func (ar *AssetResolver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+	w.Header().Add("Vary", "Accept-Language")
+
+	f, asset, err := ar.Open(CatalogReaderFromContext(r.Context()).Locale(), name)
+	switch {
+	case errors.Is(err, ErrAssetNotFound):
+		http.NotFound(w, r)
+		return
+	case err != nil:
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	content, ok := f.(io.ReadSeeker)
+	if !ok {
+		data, err := io.ReadAll(f)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		content = bytes.NewReader(data)
+	}
+
+	w.Header().Set("Content-Language", localeTag(asset.Locale))
+	http.ServeContent(w, r, info.Name(), info.ModTime(), content)
+}
@@ -0,0 +1,109 @@
+package i18n_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func newTestAssetResolver(t *testing.T) (*i18n.AssetResolver, *i18n.Catalog) {
+	catalog := i18n.NewCatalog().WithDefaultLocale("en").WithRegistry(i18n.NewLocaleRegistry().Register(
+		i18n.LocaleInfo{Locale: "fr-CA", Parent: "fr"},
+	))
+
+	fsys := fstest.MapFS{
+		"en/terms.pdf":        {Data: []byte("terms")},
+		"en/images/logo.png":  {Data: []byte("logo")},
+		"fr/terms.pdf":        {Data: []byte("conditions")},
+		"pt_BR/terms.pdf":     {Data: []byte("termos")},
+		"pt_BR/images/ad.png": {Data: []byte("anúncio")},
+	}
+
+	resolver, err := i18n.NewAssetResolver(fsys).WithCatalog(catalog).Initialize()
+	if err != nil {
+		t.Fatalf("unexpected error initializing resolver; %v", err)
+	}
+	return resolver, catalog
+}
+
+func TestAssetResolverResolve(t *testing.T) {
+	resolver, _ := newTestAssetResolver(t)
+
+	tests := []struct {
+		locale   string
+		name     string
+		expected i18n.Asset
+		exists   bool
+	}{
+		{"fr", "terms.pdf", i18n.Asset{Path: "fr/terms.pdf", Locale: "fr"}, true},
+		{"fr-CA", "terms.pdf", i18n.Asset{Path: "fr/terms.pdf", Locale: "fr"}, true},
+		{"fr-CA", "images/logo.png", i18n.Asset{Path: "en/images/logo.png", Locale: "en"}, true},
+		{"pt-br", "terms.pdf", i18n.Asset{Path: "pt_BR/terms.pdf", Locale: "pt_BR"}, true},
+		{"de", "terms.pdf", i18n.Asset{Path: "en/terms.pdf", Locale: "en"}, true},
+		{"de", "images/ad.png", i18n.Asset{}, false},
+		{"fr", "images", i18n.Asset{}, false},
+		{"fr", "../en/terms.pdf", i18n.Asset{}, false},
+	}
+
+	for _, test := range tests {
+		asset, exists := resolver.Resolve(test.locale, test.name)
+		if asset != test.expected || exists != test.exists {
+			t.Errorf("expected %v, %t for '%s' in '%s' but got %v, %t", test.expected, test.exists, test.name, test.locale, asset, exists)
+		}
+	}
+
+	if _, _, err := resolver.Open("de", "missing.pdf"); !errors.Is(err, i18n.ErrAssetNotFound) {
+		t.Errorf("expected ErrAssetNotFound but got %v", err)
+	}
+}
+
+func TestAssetResolverServeHTTP(t *testing.T) {
+	resolver, catalog := newTestAssetResolver(t)
+	handler := http.StripPrefix("/assets", resolver)
+
+	tests := []struct {
+		locale   string
+		target   string
+		status   int
+		language string
+		body     string
+	}{
+		{"pt-BR", "/assets/terms.pdf", http.StatusOK, "pt-BR", "termos"},
+		{"fr-CA", "/assets/images/logo.png", http.StatusOK, "en", "logo"},
+		{"", "/assets/terms.pdf", http.StatusOK, "en", "terms"},
+		{"fr", "/assets/missing.pdf", http.StatusNotFound, "", ""},
+	}
+
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, test.target, nil)
+		if test.locale != "" {
+			_, ctx := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale(test.locale).WithContext(context.Background())
+			r = r.WithContext(ctx)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, r)
+
+		if rec.Code != test.status {
+			t.Errorf("expected status %d for '%s' but got %d", test.status, test.target, rec.Code)
+			continue
+		}
+		if v := rec.Header().Get("Vary"); v != "Accept-Language" {
+			t.Errorf("expected Vary 'Accept-Language' for '%s' but got '%s'", test.target, v)
+		}
+		if test.status != http.StatusOK {
+			continue
+		}
+		if v := rec.Header().Get("Content-Language"); v != test.language {
+			t.Errorf("expected Content-Language '%s' for '%s' but got '%s'", test.language, test.target, v)
+		}
+		if v := rec.Body.String(); v != test.body {
+			t.Errorf("expected body '%s' for '%s' but got '%s'", test.body, test.target, v)
+		}
+	}
+}
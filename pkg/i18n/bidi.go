@@ -0,0 +1,114 @@
+package i18n
+
+import (
+	"unicode"
+)
+
+// BidiIsolation selects how formatted arguments are isolated from the surrounding bidirectional text
+type BidiIsolation int
+
+const (
+	// BidiIsolationNone leaves arguments as is
+	BidiIsolationNone BidiIsolation = iota
+	// BidiIsolationUnicode wraps arguments in the FIRST STRONG ISOLATE (U+2068) and POP DIRECTIONAL ISOLATE (U+2069)
+	// characters
+	BidiIsolationUnicode
+	// BidiIsolationHTML wraps arguments in HTML '<bdi>' elements; arguments are not escaped
+	BidiIsolationHTML
+)
+
+const (
+	firstStrongIsolate    = "\u2068"
+	popDirectionalIsolate = "\u2069"
+	leftToRightMark       = '\u200e'
+	rightToLeftMark       = '\u200f'
+	arabicLetterMark      = '\u061c'
+	bidiHTMLIsolateStart  = "<bdi>"
+	bidiHTMLIsolateEnd    = "</bdi>"
+)
+
+// rtlRanges lists the scripts of the strong right-to-left characters
+var rtlRanges = []*unicode.RangeTable{
+	unicode.Adlam, unicode.Arabic, unicode.Hanifi_Rohingya, unicode.Hebrew, unicode.Mandaic, unicode.Nko,
+	unicode.Samaritan, unicode.Syriac, unicode.Thaana,
+}
+
+// strongDirection returns the direction of a strong character, or DirectionAuto for neutral and weak characters
+// such as digits, punctuation and spaces
+func strongDirection(r rune) Direction {
+	switch {
+	case r == leftToRightMark:
+		return DirectionLTR
+	case r == rightToLeftMark || r == arabicLetterMark:
+		return DirectionRTL
+	case !unicode.IsLetter(r):
+		return DirectionAuto
+	case unicode.In(r, rtlRanges...):
+		return DirectionRTL
+	default:
+		return DirectionLTR
+	}
+}
+
+// DetectDirection returns the dominant direction of the text, the direction of most of its strong characters and of
+// the first one on a tie, or DirectionAuto when it has none, e.g. for '42'
+func DetectDirection(text string) Direction {
+	ltr, rtl, first := strongCounts(text)
+	switch {
+	case ltr > rtl:
+		return DirectionLTR
+	case rtl > ltr:
+		return DirectionRTL
+	default:
+		return first
+	}
+}
+
+// strongCounts returns the number of strong left-to-right and right-to-left characters of the text and the
+// direction of the first one
+func strongCounts(text string) (int, int, Direction) {
+	ltr, rtl, first := 0, 0, DirectionAuto
+	for _, r := range text {
+		switch strongDirection(r) {
+		case DirectionLTR:
+			ltr++
+		case DirectionRTL:
+			rtl++
+		default:
+			continue
+		}
+		if first == DirectionAuto {
+			first = strongDirection(r)
+		}
+	}
+	return ltr, rtl, first
+}
+
+// Isolate returns the text isolated for the context direction when it holds strong characters of another direction,
+// e.g. an arabic name in an english message; neutral text such as numbers is returned as is
+func (isolation BidiIsolation) Isolate(text string, context Direction) string {
+	if isolation == BidiIsolationNone {
+		return text
+	}
+
+	ltr, rtl, _ := strongCounts(text)
+	switch context {
+	case DirectionLTR:
+		if rtl == 0 {
+			return text
+		}
+	case DirectionRTL:
+		if ltr == 0 {
+			return text
+		}
+	default:
+		if ltr == 0 && rtl == 0 {
+			return text
+		}
+	}
+
+	if isolation == BidiIsolationHTML {
+		return bidiHTMLIsolateStart + text + bidiHTMLIsolateEnd
+	}
+	return firstStrongIsolate + text + popDirectionalIsolate
+}
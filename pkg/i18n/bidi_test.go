@@ -0,0 +1,76 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestDetectDirection(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected i18n.Direction
+	}{
+		{"hello", i18n.DirectionLTR},
+		{"مرحبا", i18n.DirectionRTL},
+		{"שלום world", i18n.DirectionLTR},
+		{"مرحبا بكم in", i18n.DirectionRTL},
+		{"ab אב", i18n.DirectionLTR},
+		{"אב ab", i18n.DirectionRTL},
+		{"42 - 7", i18n.DirectionAuto},
+		{"", i18n.DirectionAuto},
+	}
+
+	for _, test := range tests {
+		if v := i18n.DetectDirection(test.text); v != test.expected {
+			t.Errorf("expected '%s' for '%s' but got '%s'", test.expected, test.text, v)
+		}
+	}
+}
+
+func TestBidiIsolate(t *testing.T) {
+	tests := []struct {
+		isolation i18n.BidiIsolation
+		text      string
+		context   i18n.Direction
+		expected  string
+	}{
+		{i18n.BidiIsolationUnicode, "أحمد", i18n.DirectionLTR, "\u2068أحمد\u2069"},
+		{i18n.BidiIsolationUnicode, "Bob", i18n.DirectionLTR, "Bob"},
+		{i18n.BidiIsolationUnicode, "Bob", i18n.DirectionRTL, "\u2068Bob\u2069"},
+		{i18n.BidiIsolationUnicode, "42", i18n.DirectionRTL, "42"},
+		{i18n.BidiIsolationUnicode, "Bob", i18n.DirectionAuto, "\u2068Bob\u2069"},
+		{i18n.BidiIsolationHTML, "Bob", i18n.DirectionRTL, "<bdi>Bob</bdi>"},
+		{i18n.BidiIsolationNone, "Bob", i18n.DirectionRTL, "Bob"},
+	}
+
+	for _, test := range tests {
+		if v := test.isolation.Isolate(test.text, test.context); v != test.expected {
+			t.Errorf("expected %q for '%s' in '%s' context but got %q", test.expected, test.text, test.context, v)
+		}
+	}
+}
+
+func TestReaderBidiIsolation(t *testing.T) {
+	catalog := i18n.NewCatalog()
+	catalog.AddKeyValue("he", i18n.NewKeyPair("greeting", "שלום {name}, יש לך {count} הודעות"))
+	catalog.AddKeyValue("he", i18n.NewKeyPair("message", "שלום {name}, יש לך {count, number} הודעות"))
+	catalog.AddKeyValue("en", i18n.NewKeyPair("greeting", "{name} sent you {count} messages"))
+
+	catalogReader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("he").WithBidiIsolation(i18n.BidiIsolationUnicode)
+
+	if v, err := catalogReader.Format("greeting", i18n.Args{"name": "Bob", "count": 3}); err != nil || v != "שלום \u2068Bob\u2069, יש לך 3 הודעות" {
+		t.Errorf("expected the name to be isolated but got %q (%v)", v, err)
+	}
+	if v, err := catalogReader.FormatMessage("message", i18n.Args{"name": "Bob", "count": 3}); err != nil || v != "שלום \u2068Bob\u2069, יש לך 3 הודעות" {
+		t.Errorf("expected the name to be isolated but got %q (%v)", v, err)
+	}
+
+	catalogReader.WithLocale("en").WithBidiIsolation(i18n.BidiIsolationHTML)
+	if v, err := catalogReader.Format("greeting", i18n.Args{"name": "דוד", "count": 3}); err != nil || v != "<bdi>דוד</bdi> sent you 3 messages" {
+		t.Errorf("expected the name to be isolated but got %q (%v)", v, err)
+	}
+	if v, err := catalogReader.Format("greeting", i18n.Args{"name": "Bob", "count": 3}); err != nil || v != "Bob sent you 3 messages" {
+		t.Errorf("expected the name not to be isolated but got %q (%v)", v, err)
+	}
+}
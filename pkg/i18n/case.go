@@ -0,0 +1,264 @@
+package i18n
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// caseSpecialUpper lists the characters whose upper case is a sequence, e.g. 'SS' for 'ß'
+var caseSpecialUpper = map[rune]string{
+	'ß': "SS", 'ﬀ': "FF", 'ﬁ': "FI", 'ﬂ': "FL", 'ﬃ': "FFI", 'ﬄ': "FFL", 'ﬅ': "ST", 'ﬆ': "ST", 'ŉ': "ʼN",
+}
+
+// caseSpecialTitle lists the characters whose title case is a sequence, e.g. 'Ss' for 'ß'
+var caseSpecialTitle = map[rune]string{
+	'ß': "Ss", 'ﬀ': "Ff", 'ﬁ': "Fi", 'ﬂ': "Fl", 'ﬃ': "Ffi", 'ﬄ': "Ffl", 'ﬅ': "St", 'ﬆ': "St", 'ŉ': "ʼN",
+}
+
+// greekAccents lists the greek accents and breathings removed by the greek upper case
+const greekAccents = "\u0300\u0301\u0313\u0314\u0342\u0345"
+
+var (
+	compositionsOnce sync.Once
+	compositions     map[string]rune // canonical decomposition -> precomposed character
+)
+
+func loadCompositions() {
+	collationOnce.Do(loadCollationData)
+
+	compositions = make(map[string]rune, len(decompositions))
+	for r, decomposition := range decompositions {
+		// greek extended duplicates of greek letters such as U+1FCB for U+0389 do not compose
+		if existing, exists := compositions[string(decomposition)]; len(decomposition) > 1 && (!exists || r < existing) {
+			compositions[string(decomposition)] = r
+		}
+	}
+}
+
+// compose returns the runes with each letter and its following marks replaced by their precomposed character, when
+// there is one
+func compose(runes []rune) string {
+	compositionsOnce.Do(loadCompositions)
+
+	var sb strings.Builder
+	for i := 0; i < len(runes); {
+		end := i + 1
+		for end < len(runes) && unicode.Is(unicode.Mn, runes[end]) {
+			end++
+		}
+
+		for n := end; n > i; n-- {
+			if n == i+1 {
+				sb.WriteRune(runes[i])
+				i++
+				break
+			}
+			if r, exists := compositions[string(runes[i:n])]; exists {
+				sb.WriteRune(r)
+				i = n
+				break
+			}
+		}
+		for ; i < end; i++ {
+			sb.WriteRune(runes[i])
+		}
+	}
+	return sb.String()
+}
+
+// CaseMapper converts text to upper, lower, title or sentence case following the CLDR case mappings of a locale,
+// e.g. 'İ' for 'i' in turkish or the final sigma 'ς' in greek
+type CaseMapper struct {
+	language string
+}
+
+// NewCaseMapper returns a new CaseMapper for the specified locale
+func NewCaseMapper(locale string) *CaseMapper {
+	return &CaseMapper{language: localeLanguage(locale)}
+}
+
+// turkic returns whether the language uses the turkish dotted and dotless i
+func (cm *CaseMapper) turkic() bool {
+	return cm != nil && (cm.language == "tr" || cm.language == "az")
+}
+
+// upper returns the upper case of a character
+func (cm *CaseMapper) upper(r rune) string {
+	switch {
+	case caseSpecialUpper[r] != "":
+		return caseSpecialUpper[r]
+	case cm.turkic():
+		return string(unicode.TurkishCase.ToUpper(r))
+	default:
+		return string(unicode.ToUpper(r))
+	}
+}
+
+// title returns the title case of a character
+func (cm *CaseMapper) title(r rune) string {
+	switch {
+	case caseSpecialTitle[r] != "":
+		return caseSpecialTitle[r]
+	case cm.turkic():
+		return string(unicode.TurkishCase.ToTitle(r))
+	default:
+		return string(unicode.ToTitle(r))
+	}
+}
+
+// lower returns the lower case of the character at the index, using the final sigma at the end of words
+func (cm *CaseMapper) lower(runes []rune, index int) rune {
+	r := runes[index]
+	switch {
+	case r == 'Σ':
+		if finalSigma(runes, index) {
+			return 'ς'
+		}
+		return 'σ'
+	case cm.turkic():
+		return unicode.TurkishCase.ToLower(r)
+	default:
+		return unicode.ToLower(r)
+	}
+}
+
+// finalSigma returns whether the sigma at the index follows a letter and does not precede one, ignoring marks
+func finalSigma(runes []rune, index int) bool {
+	before := index - 1
+	for before >= 0 && unicode.Is(unicode.Mn, runes[before]) {
+		before--
+	}
+	after := index + 1
+	for after < len(runes) && unicode.Is(unicode.Mn, runes[after]) {
+		after++
+	}
+	return before >= 0 && unicode.IsLetter(runes[before]) && (after == len(runes) || !unicode.IsLetter(runes[after]))
+}
+
+// Upper returns the text in upper case, e.g. 'İSTANBUL' for 'istanbul' in turkish, 'STRASSE' for 'straße' or
+// 'ΕΛΛΗΝΙΚΑ' for 'Ελληνικά' in greek, which drops the accents
+func (cm *CaseMapper) Upper(text string) string {
+	if cm != nil && cm.language == "el" {
+		return cm.greekUpper(text)
+	}
+
+	var sb strings.Builder
+	for _, r := range text {
+		sb.WriteString(cm.upper(r))
+	}
+	return sb.String()
+}
+
+// greekUpper returns the text in upper case without the accents of greek letters, adding a diaeresis to the 'ι' or
+// 'υ' following an accented vowel ('ΑΪ' for 'άι') and keeping the accent of the disjunctive 'ή'
+func (cm *CaseMapper) greekUpper(text string) string {
+	collationOnce.Do(loadCollationData)
+
+	runes := decompose(text)
+	upper := make([]rune, 0, len(runes))
+	accented := false
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if !unicode.Is(unicode.Greek, r) || !unicode.IsLetter(r) {
+			accented = accented && unicode.Is(unicode.Mn, r)
+			upper = append(upper, []rune(cm.upper(r))...)
+			continue
+		}
+
+		end := i + 1
+		for end < len(runes) && unicode.Is(unicode.Mn, runes[end]) {
+			end++
+		}
+		marks := runes[i+1 : end]
+
+		var kept []rune
+		removed := false
+		for _, mark := range marks {
+			if strings.ContainsRune(greekAccents, mark) && !disjunctiveEta(runes, i, end) {
+				removed = true
+			} else {
+				kept = append(kept, mark)
+			}
+		}
+
+		upper = append(upper, unicode.ToUpper(r))
+		if accented && len(marks) == 0 && strings.ContainsRune("ιυΙΥ", r) {
+			kept = append(kept, '\u0308')
+		}
+		upper = append(upper, kept...)
+
+		accented = removed && strings.ContainsRune("αεηιουωΑΕΗΙΟΥΩ", r)
+		i = end - 1
+	}
+	return compose(upper)
+}
+
+// disjunctiveEta returns whether the letter at the index, followed by marks up to the end index, is the word 'ή'
+func disjunctiveEta(runes []rune, index int, end int) bool {
+	return (runes[index] == 'η' || runes[index] == 'Η') && (index == 0 || !unicode.IsLetter(runes[index-1])) &&
+		(end == len(runes) || !unicode.IsLetter(runes[end]))
+}
+
+// Lower returns the text in lower case, e.g. 'ırmak' for 'IRMAK' in turkish or 'σας' for 'ΣΑΣ'
+func (cm *CaseMapper) Lower(text string) string {
+	runes := []rune(text)
+
+	var sb strings.Builder
+	for i := range runes {
+		sb.WriteRune(cm.lower(runes, i))
+	}
+	return sb.String()
+}
+
+// Title returns the text with the first letter of each word in title case and the other letters in lower case, e.g.
+// 'Hello World' for 'hello WORLD' or 'IJsland' for 'ijsland' in dutch; apostrophes within words do not start a word
+func (cm *CaseMapper) Title(text string) string {
+	return cm.titleCase(text, false)
+}
+
+// Sentence returns the text with its first letter in title case and the other letters in lower case, e.g. 'Save
+// changes' for 'SAVE CHANGES'
+func (cm *CaseMapper) Sentence(text string) string {
+	return cm.titleCase(text, true)
+}
+
+// titleCase returns the text with the first letter of each word, or of the first word only, in title case and the
+// other letters in lower case
+func (cm *CaseMapper) titleCase(text string, firstWordOnly bool) string {
+	runes := []rune(text)
+
+	var sb strings.Builder
+	inWord, titled := false, false
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case unicode.IsLetter(r) && !inWord && !(firstWordOnly && titled):
+			sb.WriteString(cm.title(r))
+			if cm != nil && cm.language == "nl" && (r == 'i' || r == 'I') && i+1 < len(runes) && (runes[i+1] == 'j' || runes[i+1] == 'J') {
+				sb.WriteRune('J')
+				i++
+			}
+			inWord, titled = true, true
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r):
+			inWord = true
+		case (r == '\'' || r == '’') && inWord && i+1 < len(runes) && unicode.IsLetter(runes[i+1]):
+		default:
+			inWord = false
+		}
+		sb.WriteRune(cm.lower(runes, i))
+	}
+	return sb.String()
+}
+
+// CaseFuncs returns the 'upper', 'lower', 'title' and 'sentence' functions of the case mapper, e.g. to add to a
+// text/template or html/template FuncMap
+func (cm *CaseMapper) CaseFuncs() map[string]any {
+	return map[string]any{
+		"upper":    cm.Upper,
+		"lower":    cm.Lower,
+		"title":    cm.Title,
+		"sentence": cm.Sentence,
+	}
+}
@@ -0,0 +1,59 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestCaseMapper(t *testing.T) {
+	tests := []struct {
+		actual   string
+		expected string
+	}{
+		{i18n.NewCaseMapper("en").Upper("istanbul"), "ISTANBUL"},
+		{i18n.NewCaseMapper("tr").Upper("istanbul ılık"), "İSTANBUL ILIK"},
+		{i18n.NewCaseMapper("az").Lower("IRMAK İLK"), "ırmak ilk"},
+		{i18n.NewCaseMapper("en").Lower("IRMAK"), "irmak"},
+		{i18n.NewCaseMapper("de").Upper("straße"), "STRASSE"},
+		{i18n.NewCaseMapper("tr").Upper("ﬁyat"), "FIYAT"},
+		{i18n.NewCaseMapper("el").Lower("ΟΔΟΣ ΣΑΣ"), "οδος σας"},
+		{i18n.NewCaseMapper("el").Upper("Ελληνικά"), "ΕΛΛΗΝΙΚΑ"},
+		{i18n.NewCaseMapper("el").Upper("άι ή ΐ"), "ΑΪ Ή Ϊ"},
+		{i18n.NewCaseMapper("el").Upper("café"), "CAFÉ"},
+		{i18n.NewCaseMapper("en").Upper("Ελληνικά"), "ΕΛΛΗΝΙΚΆ"},
+		{i18n.NewCaseMapper("en").Title("hello WORLD, don't stop"), "Hello World, Don't Stop"},
+		{i18n.NewCaseMapper("fr").Title("l'été à paris"), "L'été À Paris"},
+		{i18n.NewCaseMapper("nl").Title("ijsland en ijmuiden"), "IJsland En IJmuiden"},
+		{i18n.NewCaseMapper("tr").Title("izmir"), "İzmir"},
+		{i18n.NewCaseMapper("en").Title("1st place"), "1st Place"},
+		{i18n.NewCaseMapper("en").Sentence("SAVE CHANGES"), "Save changes"},
+		{i18n.NewCaseMapper("tr").Sentence("İPTAL ET"), "İptal et"},
+	}
+
+	for _, test := range tests {
+		if test.actual != test.expected {
+			t.Errorf("expected '%s' but got '%s'", test.expected, test.actual)
+		}
+	}
+}
+
+func TestReaderCase(t *testing.T) {
+	catalog := i18n.NewCatalog()
+	catalog.AddKeyValue("tr", i18n.NewKeyPair("button.save", "kaydet ve çık"))
+
+	catalogReader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("tr")
+	value := catalogReader.Get("button.save").Value()
+
+	if v := catalogReader.Upper(value); v != "KAYDET VE ÇIK" {
+		t.Errorf("expected 'KAYDET VE ÇIK' but got '%s'", v)
+	}
+	if v := catalogReader.Title(value); v != "Kaydet Ve Çık" {
+		t.Errorf("expected 'Kaydet Ve Çık' but got '%s'", v)
+	}
+
+	upper, ok := catalogReader.CaseFuncs()["upper"].(func(string) string)
+	if !ok || upper("iş") != "İŞ" {
+		t.Errorf("expected an 'upper' function returning 'İŞ'")
+	}
+}
@@ -2,10 +2,12 @@
 package i18n
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 )
@@ -17,9 +19,18 @@ type Catalog struct {
 
 	defaultLocale string
 	localeFilters map[string]bool
+	registry      *LocaleRegistry
+
+	missingKeyHandler MissingKeyHandler
+	collector         *MissingKeyCollector
+	validators        []KeyValueValidator
+	localeValidators  []LocaleValidator
 
 	stats CatalogStats
 
+	templates sync.Map
+	messages  sync.Map
+
 	lock sync.RWMutex
 }
 
@@ -36,9 +47,10 @@ const (
 // NewCatalog returns a new I18N Catalog
 func NewCatalog() *Catalog {
 	c := &Catalog{
-		parser:        NewKeyPairFSParser([]string{"./locales"}),
-		locales:       make(map[string]map[string]KeyValue),
-		localeFilters: make(map[string]bool),
+		parser:            NewKeyPairFSParser([]string{"./locales"}),
+		locales:           make(map[string]map[string]KeyValue),
+		localeFilters:     make(map[string]bool),
+		missingKeyHandler: MissingKeyAsUnknown,
 	}
 
 	env, exists := os.LookupEnv(DefaultLocaleEnvironment)
@@ -71,6 +83,47 @@ func (c *Catalog) WithDefaultLocale(locale string) *Catalog {
 	return c
 }
 
+// WithRegistry sets the locale registry consulted for the fallback parents, plural rules and enabled state of locales
+func (c *Catalog) WithRegistry(registry *LocaleRegistry) *Catalog {
+	if c != nil {
+		c.registry = registry
+	}
+	return c
+}
+
+// WithMissingKeyHandler sets the handler used to produce a KeyValue when a key can not be found
+func (c *Catalog) WithMissingKeyHandler(handler MissingKeyHandler) *Catalog {
+	if c != nil {
+		c.missingKeyHandler = handler
+	}
+	return c
+}
+
+// WithCollector attaches a collector that records missing keys and default locale fallbacks
+func (c *Catalog) WithCollector(collector *MissingKeyCollector) *Catalog {
+	if c != nil {
+		c.collector = collector
+	}
+	return c
+}
+
+// WithValidators adds validators that are run against every keyValue when the catalog is initialized
+func (c *Catalog) WithValidators(validators ...KeyValueValidator) *Catalog {
+	if c != nil {
+		c.validators = append(c.validators, validators...)
+	}
+	return c
+}
+
+// WithLocaleValidators adds validators that are run against the keyValues of every locale when the catalog is
+// initialized
+func (c *Catalog) WithLocaleValidators(validators ...LocaleValidator) *Catalog {
+	if c != nil {
+		c.localeValidators = append(c.localeValidators, validators...)
+	}
+	return c
+}
+
 // WithLocales will take the specified locales and apply them as a filter when loading catalog entries
 func (c *Catalog) WithLocales(locales ...string) *Catalog {
 	if c != nil {
@@ -96,9 +149,43 @@ func (c *Catalog) Initialize() (*Catalog, error) {
 		return nil, errNoCatalog
 	case c.parser == nil:
 		return c, errNoParser
-	default:
-		return c, c.parser.Parse(c.AddKeyValue)
 	}
+
+	c.clearCaches()
+	if err := c.parser.Parse(c.AddKeyValue); err != nil {
+		return c, err
+	}
+
+	return c, c.Validate()
+}
+
+// Validate runs the catalog validators against every keyValue, returning all validation errors
+func (c *Catalog) Validate() error {
+	if c == nil {
+		return errNoCatalog
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var errs []error
+	for locale, localeEntry := range c.locales {
+		for _, validator := range c.localeValidators {
+			if err := validator(locale, localeEntry); err != nil {
+				errs = append(errs, fmt.Errorf("invalid locale '%s': %w", locale, err))
+			}
+		}
+
+		for key, keyValue := range localeEntry {
+			for _, validator := range c.validators {
+				if err := validator(locale, keyValue); err != nil {
+					errs = append(errs, fmt.Errorf("invalid value for key '%s' in locale '%s': %w", key, locale, err))
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
 }
 
 // Initialize loads keyValues using the catalog parser and returns a new context
@@ -119,29 +206,100 @@ func (c *Catalog) InitializeWithContext() (context.Context, error) {
 // Get returns the KeyValue for the specified key for the specified locale
 func (c *Catalog) Get(locale string, key string) KeyValue {
 	if c == nil {
-		return NewUnknownKeyPair(key)
+		return MissingKeyAsUnknown(locale, key)
+	}
+
+	value, exists := c.resolve(locale, key)
+	if !exists {
+		return c.handleMissingKey(locale, key)
+	}
+
+	return value
+}
+
+// Lookup returns the KeyValue for the specified key for the specified locale, falling back to the default locale,
+// and whether it was found
+func (c *Catalog) Lookup(locale string, key string) (KeyValue, bool) {
+	value, _, exists := c.lookup(locale, key)
+	return value, exists
+}
+
+func (c *Catalog) lookup(locale string, key string) (KeyValue, string, bool) {
+	return c.lookupVariant(locale, func(string) []string { return []string{key} })
+}
+
+// lookupVariant walks the locale fallback chain, returning the first keyValue found for the keys returned by
+// keysFor for each locale
+func (c *Catalog) lookupVariant(locale string, keysFor func(locale string) []string) (KeyValue, string, bool) {
+	if c == nil {
+		return nil, "", false
 	}
 
 	c.lock.RLock()
 	defer c.lock.RUnlock()
 
-	localeEntry, exists := c.locales[locale]
-	switch {
-	case !exists && len(c.defaultLocale) > 0 && c.defaultLocale != locale:
-		return c.Get(c.defaultLocale, key)
-	case !exists:
-		return NewUnknownKeyPair(key)
+	for _, candidate := range c.fallbackChain(locale) {
+		for _, key := range keysFor(candidate) {
+			if value, exists := c.locales[candidate][key]; exists {
+				return value, candidate, true
+			}
+		}
+	}
+
+	return nil, "", false
+}
+
+// fallbackChain returns the locales to search, in order, for the specified locale: the locale and its registry
+// parents, skipping disabled locales, followed by the default locale
+func (c *Catalog) fallbackChain(locale string) []string {
+	chain := make([]string, 0, 2)
+	seen := make(map[string]bool)
+	for candidate := locale; candidate != "" && !seen[candidate]; candidate = c.registry.Parent(candidate) {
+		seen[candidate] = true
+		if c.registry.Enabled(candidate) {
+			chain = append(chain, candidate)
+		}
+	}
+
+	if len(c.defaultLocale) > 0 && !seen[c.defaultLocale] {
+		chain = append(chain, c.defaultLocale)
+	}
+	return chain
+}
+
+// resolve looks up the key like Lookup, reporting misses and fallbacks to the collector
+func (c *Catalog) resolve(locale string, key string) (KeyValue, bool) {
+	value, resolvedLocale, exists := c.lookup(locale, key)
+	c.collect(locale, resolvedLocale, key, exists)
+	return value, exists
+}
+
+// resolveVariant looks up a variant of the key like lookupVariant, reporting misses and fallbacks of the key to the
+// collector
+func (c *Catalog) resolveVariant(locale string, key string, keysFor func(locale string) []string) (KeyValue, bool) {
+	value, resolvedLocale, exists := c.lookupVariant(locale, keysFor)
+	c.collect(locale, resolvedLocale, key, exists)
+	return value, exists
+}
+
+func (c *Catalog) collect(locale string, resolvedLocale string, key string, exists bool) {
+	if c == nil || c.collector == nil {
+		return
 	}
 
-	value, exists := localeEntry[key]
 	switch {
-	case !exists && len(c.defaultLocale) > 0 && c.defaultLocale != locale:
-		return c.Get(c.defaultLocale, key)
 	case !exists:
-		return NewUnknownKeyPair(key)
+		c.collector.RecordMiss(locale, key)
+	case resolvedLocale != locale:
+		c.collector.RecordFallback(locale, resolvedLocale, key)
 	}
+}
 
-	return value
+func (c *Catalog) handleMissingKey(locale string, key string) KeyValue {
+	if c.missingKeyHandler == nil {
+		return MissingKeyAsUnknown(locale, key)
+	}
+	return c.missingKeyHandler(locale, key)
 }
 
 // Locales returns a copy of the catalog locale filters
@@ -162,6 +320,97 @@ func (c *Catalog) Locales() []string {
 	}
 }
 
+// LoadedLocales returns the sorted locales that have at least one key value in the catalog, excluding the locales
+// disabled in the registry
+func (c *Catalog) LoadedLocales() []string {
+	switch {
+	case c == nil:
+		return []string{}
+	default:
+		c.lock.RLock()
+		defer c.lock.RUnlock()
+
+		locales := make([]string, 0, len(c.locales))
+		for locale := range c.locales {
+			if c.registry.Enabled(locale) {
+				locales = append(locales, locale)
+			}
+		}
+		sort.Strings(locales)
+
+		return locales
+	}
+}
+
+// matchLocale returns the loaded locale matching the locale or, failing that, one of its truncated parents, comparing
+// normalized locales, e.g. 'fr-FR' or 'fr' for 'fr_FR'; it returns the locale itself when none matches
+func (c *Catalog) matchLocale(locale string) string {
+	loaded := make(map[string]string)
+	for _, name := range c.LoadedLocales() {
+		loaded[normalizeLocale(name)] = name
+	}
+
+	for _, candidate := range localeCandidates(locale) {
+		if name, exists := loaded[candidate]; exists {
+			return name
+		}
+	}
+	return locale
+}
+
+// Registry returns the locale registry of the catalog, nil if none is set
+func (c *Catalog) Registry() *LocaleRegistry {
+	if c == nil {
+		return nil
+	}
+	return c.registry
+}
+
+// LocaleInfo returns the metadata of the locale from the catalog registry, or derived from the CLDR data
+func (c *Catalog) LocaleInfo(locale string) LocaleInfo {
+	return c.Registry().Info(locale)
+}
+
+// direction returns the writing direction of the locale from the catalog registry, or derived from the locale script
+func (c *Catalog) direction(locale string) Direction {
+	if info, exists := c.Registry().Lookup(locale); exists && info.Direction != DirectionAuto {
+		return info.Direction
+	}
+	return LocaleDirection(locale)
+}
+
+// pluralLocale returns the locale whose plural rules apply to the specified locale
+func (c *Catalog) pluralLocale(locale string) string {
+	if info, exists := c.Registry().Lookup(locale); exists && info.PluralRules != "" {
+		return info.PluralRules
+	}
+	return locale
+}
+
+// SortedKeys returns the keys of the locale sorted by their values with the collator of the locale, e.g. to list the
+// keys of country names in a menu; keys with equal values are sorted by key
+func (c *Catalog) SortedKeys(locale string) []string {
+	if c == nil {
+		return []string{}
+	}
+
+	c.lock.RLock()
+	keys := make([]string, 0, len(c.locales[locale]))
+	sortKeys := make(map[string][]byte, len(c.locales[locale]))
+	collator := NewCollator(locale)
+	for key, keyValue := range c.locales[locale] {
+		keys = append(keys, key)
+		sortKeys[key] = collator.Key(keyValue.Value())
+	}
+	c.lock.RUnlock()
+
+	sort.Strings(keys)
+	sort.SliceStable(keys, func(i, j int) bool {
+		return bytes.Compare(sortKeys[keys[i]], sortKeys[keys[j]]) < 0
+	})
+	return keys
+}
+
 // Stats returns a copy of the catalog stats
 func (c *Catalog) Stats() CatalogStats {
 	switch {
@@ -212,14 +461,96 @@ func (c *Catalog) AddKeyValue(locale string, keyValue KeyValue) {
 		c.stats.Locales++
 	}
 
-	_, exists = localeEntry[keyValue.Key()]
+	previous, exists := localeEntry[keyValue.Key()]
 	if !exists {
 		c.stats.Keys++
+	} else if previous.Value() != keyValue.Value() {
+		c.uncache(templateCacheKey{key: previous.Key(), value: previous.Value()})
 	}
 
 	localeEntry[keyValue.Key()] = keyValue
 }
 
+// templateCacheKey identifies the compiled templates and messages of a key value in the catalog caches
+type templateCacheKey struct {
+	key   string
+	value string
+}
+
+// cache stores the compiled template or message of the key value if the key value is in the catalog, so that the
+// values of missing keys, such as '[unknown key:...]' placeholders, do not grow the caches
+func (c *Catalog) cache(cache *sync.Map, cacheKey templateCacheKey, compiled any) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	for _, localeEntry := range c.locales {
+		if keyValue, exists := localeEntry[cacheKey.key]; exists && keyValue.Value() == cacheKey.value {
+			cache.Store(cacheKey, compiled)
+			return
+		}
+	}
+}
+
+// uncache removes the compiled template and message of a key value replaced in the catalog; the caller holds the lock
+func (c *Catalog) uncache(cacheKey templateCacheKey) {
+	c.templates.Delete(cacheKey)
+	c.messages.Delete(cacheKey)
+}
+
+// clearCaches removes all of the compiled templates and messages, e.g. before reloading the catalog
+func (c *Catalog) clearCaches() {
+	c.templates.Range(func(cacheKey, _ any) bool {
+		c.templates.Delete(cacheKey)
+		return true
+	})
+	c.messages.Range(func(cacheKey, _ any) bool {
+		c.messages.Delete(cacheKey)
+		return true
+	})
+}
+
+// Template returns the compiled MessageTemplate for the specified keyValue, compiling it on first use and caching it
+// when the keyValue is in the catalog
+func (c *Catalog) Template(keyValue KeyValue) (*MessageTemplate, error) {
+	if c == nil {
+		return CompileMessageTemplate(keyValue.Value())
+	}
+
+	cacheKey := templateCacheKey{key: keyValue.Key(), value: keyValue.Value()}
+	if mt, exists := c.templates.Load(cacheKey); exists {
+		return mt.(*MessageTemplate), nil
+	}
+
+	mt, err := CompileMessageTemplate(cacheKey.value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile template for key '%s': %w", cacheKey.key, err)
+	}
+
+	c.cache(&c.templates, cacheKey, mt)
+	return mt, nil
+}
+
+// MessageFormat returns the compiled MessageFormat for the specified keyValue, compiling it on first use and caching
+// it when the keyValue is in the catalog
+func (c *Catalog) MessageFormat(keyValue KeyValue) (*MessageFormat, error) {
+	if c == nil {
+		return CompileMessageFormat(keyValue.Value())
+	}
+
+	cacheKey := templateCacheKey{key: keyValue.Key(), value: keyValue.Value()}
+	if mf, exists := c.messages.Load(cacheKey); exists {
+		return mf.(*MessageFormat), nil
+	}
+
+	mf, err := CompileMessageFormat(cacheKey.value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile message for key '%s': %w", cacheKey.key, err)
+	}
+
+	c.cache(&c.messages, cacheKey, mf)
+	return mf, nil
+}
+
 // CatalogFromContext will return the catalog contained in the specified context
 func CatalogFromContext(ctx context.Context) *Catalog {
 	switch {
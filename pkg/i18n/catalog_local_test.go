@@ -0,0 +1,51 @@
+package i18n
+
+import (
+	"sync"
+	"testing"
+)
+
+func cacheSize(cache *sync.Map) int {
+	size := 0
+	cache.Range(func(_, _ any) bool {
+		size++
+		return true
+	})
+	return size
+}
+
+func TestCatalogTemplateCache(t *testing.T) {
+	catalog := NewCatalog()
+	catalog.AddKeyValue("en", NewKeyPair("greeting", "Hello {0}"))
+	reader := NewCatalogReader().WithCatalog(catalog).WithLocale("en")
+
+	for _, key := range []string{"missing.a", "missing.b", "missing.c"} {
+		_, _ = reader.Format(key)
+		_, _ = reader.FormatMessage(key)
+	}
+	if templates, messages := cacheSize(&catalog.templates), cacheSize(&catalog.messages); templates != 0 || messages != 0 {
+		t.Errorf("expected no cached missing keys but got %d templates and %d messages", templates, messages)
+	}
+
+	_, _ = reader.Format("greeting", "Bob")
+	_, _ = reader.FormatMessage("greeting", "Bob")
+	if templates, messages := cacheSize(&catalog.templates), cacheSize(&catalog.messages); templates != 1 || messages != 1 {
+		t.Errorf("expected 1 cached template and message but got %d and %d", templates, messages)
+	}
+
+	catalog.AddKeyValue("en", NewKeyPair("greeting", "Hi {0}"))
+	if templates, messages := cacheSize(&catalog.templates), cacheSize(&catalog.messages); templates != 0 || messages != 0 {
+		t.Errorf("expected the replaced value to be removed but got %d templates and %d messages", templates, messages)
+	}
+	if v, _ := reader.Format("greeting", "Bob"); v != "Hi Bob" {
+		t.Errorf("expected 'Hi Bob' but got '%s'", v)
+	}
+
+	catalog.WithParser(NewKeyPairFSParser([]string{}))
+	if _, err := catalog.Initialize(); err != nil {
+		t.Fatalf("unexpected error initializing catalog; %v", err)
+	}
+	if templates := cacheSize(&catalog.templates); templates != 0 {
+		t.Errorf("expected the caches to be cleared on initialization but got %d templates", templates)
+	}
+}
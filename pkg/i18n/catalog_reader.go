@@ -1,13 +1,21 @@
 package i18n
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"os"
+	"sort"
+	"strconv"
+	"time"
 )
 
 type CatalogReader struct {
 	catalog *Catalog
 	locale  string
+
+	missingKeyHandler MissingKeyHandler
+	bidiIsolation     BidiIsolation
 }
 
 const (
@@ -31,17 +39,382 @@ func NewCatalogReader() *CatalogReader {
 // Get returns the KeyValue associated with the specified key
 func (cr *CatalogReader) Get(key string) KeyValue {
 	if cr != nil {
-		return cr.catalog.Get(cr.locale, key)
+		return cr.GetWithLocale(cr.locale, key)
 	}
 	return NewUnknownKeyPair(key)
 }
 
+// resolve returns the KeyValue associated with the specified key like Get, and whether it was found in the catalog
+// rather than provided by the missing key handler
+func (cr *CatalogReader) resolve(key string) (KeyValue, bool) {
+	if cr == nil {
+		return NewUnknownKeyPair(key), false
+	}
+
+	if value, exists := cr.catalog.resolve(cr.locale, key); exists {
+		return value, true
+	}
+	return cr.handleMissingKey(cr.locale, key), false
+}
+
 // GetWithLocale returns the KeyValue associated with the specified key using the specified locale
 func (cr *CatalogReader) GetWithLocale(locale string, key string) KeyValue {
+	if cr == nil {
+		return NewUnknownKeyPair(key)
+	}
+
+	if value, exists := cr.catalog.resolve(locale, key); exists {
+		return value
+	}
+	return cr.handleMissingKey(locale, key)
+}
+
+// Format returns the value associated with the specified key with its placeholders replaced by the specified arguments;
+// Args arguments supply named placeholders ('{name}') while all other arguments supply positional placeholders ('{0}')
+func (cr *CatalogReader) Format(key string, args ...any) (string, error) {
+	return cr.formatValue(cr.Get(key), cr.formatArg, args...)
+}
+
+// formatValue returns the value with its placeholders replaced by the arguments formatted with formatArg
+func (cr *CatalogReader) formatValue(keyValue KeyValue, formatArg func(...any) string, args ...any) (string, error) {
+	var catalog *Catalog
 	if cr != nil {
-		return cr.catalog.Get(locale, key)
+		catalog = cr.catalog
 	}
-	return NewUnknownKeyPair(key)
+
+	mt, err := catalog.Template(keyValue)
+	if err != nil {
+		return keyValue.Value(), err
+	}
+
+	return mt.execute(formatArg, args...)
+}
+
+// FormatMessage returns the value associated with the specified key rendered as an ICU MessageFormat message using
+// the reader locale; Args arguments supply named arguments while all other arguments are named by their position
+func (cr *CatalogReader) FormatMessage(key string, args ...any) (string, error) {
+	return cr.formatMessageValue(cr.Get(key), cr.formatArg, args...)
+}
+
+// formatMessageValue returns the value rendered as an ICU MessageFormat message, formatting simple arguments with
+// formatArg
+func (cr *CatalogReader) formatMessageValue(keyValue KeyValue, formatArg func(...any) string, args ...any) (string, error) {
+	var catalog *Catalog
+	locale := ""
+	if cr != nil {
+		catalog, locale = cr.catalog, cr.locale
+	}
+
+	mf, err := catalog.MessageFormat(keyValue)
+	if err != nil {
+		return keyValue.Value(), err
+	}
+
+	named, positional := splitArgs(args)
+	for i, arg := range positional {
+		named[strconv.Itoa(i)] = arg
+	}
+
+	return mf.format(&messageState{locale: locale, args: named, formatArg: formatArg})
+}
+
+// Plural returns the plural variant of the specified key ('key[one]', 'key[few]', ...) selected by the CLDR cardinal
+// rules of the locale for the specified count, falling back to 'key[other]' and then to the key itself
+func (cr *CatalogReader) Plural(key string, count any) KeyValue {
+	return cr.pluralVariant(key, func(locale string) PluralCategory {
+		return CardinalRules(locale).Select(count)
+	})
+}
+
+// Ordinal returns the plural variant of the specified key selected by the CLDR ordinal rules of the locale for the
+// specified position, e.g. 'place[one]' for 1 and 'place[two]' for 22 in english
+func (cr *CatalogReader) Ordinal(key string, position any) KeyValue {
+	return cr.pluralVariant(key, func(locale string) PluralCategory {
+		return OrdinalRules(locale).Select(position)
+	})
+}
+
+// PluralRange returns the plural variant of the specified key selected by the CLDR plural range rules of the locale
+// for the range from start to end, e.g. 'days[other]' for 1-3 days in english
+func (cr *CatalogReader) PluralRange(key string, start any, end any) KeyValue {
+	return cr.pluralVariant(key, func(locale string) PluralCategory {
+		return SelectRange(locale, start, end)
+	})
+}
+
+// Select returns the variant of the specified key for the specified selector values, e.g. 'greeting[female]' for
+// Select("greeting", "female"); each selector falls back to 'other', and finally to the key itself
+func (cr *CatalogReader) Select(key string, selectors ...string) KeyValue {
+	if cr == nil {
+		return NewUnknownKeyPair(key)
+	}
+
+	candidates := variantCandidates(key, selectors)
+	keysFor := func(string) []string { return candidates }
+
+	if value, exists := cr.catalog.resolveVariant(cr.locale, key, keysFor); exists {
+		return value
+	}
+	return cr.handleMissingKey(cr.locale, key)
+}
+
+func (cr *CatalogReader) pluralVariant(key string, categoryFor func(locale string) PluralCategory) KeyValue {
+	value, _ := cr.resolvePluralVariant(key, categoryFor)
+	return value
+}
+
+// resolvePluralVariant returns the plural variant of the key like pluralVariant, and whether it was found in the
+// catalog rather than provided by the missing key handler
+func (cr *CatalogReader) resolvePluralVariant(key string, categoryFor func(locale string) PluralCategory) (KeyValue, bool) {
+	if cr == nil {
+		return NewUnknownKeyPair(key), false
+	}
+
+	keysFor := func(locale string) []string {
+		return []string{PluralKey(key, categoryFor(cr.catalog.pluralLocale(locale))), PluralKey(key, PluralOther), key}
+	}
+
+	if value, exists := cr.catalog.resolveVariant(cr.locale, key, keysFor); exists {
+		return value, true
+	}
+	return cr.handleMissingKey(cr.locale, key), false
+}
+
+// formatArg returns the template argument as a string isolated for the reader direction
+func (cr *CatalogReader) formatArg(args ...any) string {
+	return cr.isolate(fmt.Sprint(args...))
+}
+
+// isolate returns the text isolated for the reader direction using the reader bidi isolation
+func (cr *CatalogReader) isolate(text string) string {
+	if cr == nil || cr.bidiIsolation == BidiIsolationNone {
+		return text
+	}
+	return cr.bidiIsolation.Isolate(text, cr.Direction())
+}
+
+func (cr *CatalogReader) handleMissingKey(locale string, key string) KeyValue {
+	switch {
+	case cr.missingKeyHandler != nil:
+		return cr.missingKeyHandler(locale, key)
+	case cr.catalog != nil:
+		return cr.catalog.handleMissingKey(locale, key)
+	default:
+		return MissingKeyAsUnknown(locale, key)
+	}
+}
+
+// NumberFormatter returns a new NumberFormatter for the reader locale
+func (cr *CatalogReader) NumberFormatter() *NumberFormatter {
+	if cr == nil {
+		return NewNumberFormatter("")
+	}
+	return NewNumberFormatter(cr.locale)
+}
+
+// FormatNumber returns the number formatted as a decimal for the reader locale
+func (cr *CatalogReader) FormatNumber(number any) string {
+	return cr.NumberFormatter().Format(number)
+}
+
+// FormatCurrency returns the amount formatted in the ISO 4217 currency for the reader locale
+func (cr *CatalogReader) FormatCurrency(amount any, code string) string {
+	return cr.NumberFormatter().WithCurrency(code).Format(amount)
+}
+
+// FormatCompact returns the number formatted as a short compact decimal for the reader locale, e.g. '1.2K'
+func (cr *CatalogReader) FormatCompact(number any) string {
+	return cr.NumberFormatter().WithStyle(NumberCompact).Format(number)
+}
+
+// FormatByteSize returns the number of bytes formatted with decimal (kB, MB, ...) or binary (KiB, MiB, ...) units for
+// the reader locale
+func (cr *CatalogReader) FormatByteSize(bytes any, binary bool) string {
+	if binary {
+		return cr.NumberFormatter().WithStyle(NumberBinaryByteSize).Format(bytes)
+	}
+	return cr.NumberFormatter().WithStyle(NumberByteSize).Format(bytes)
+}
+
+// DateTimeFormatter returns a new DateTimeFormatter for the reader locale
+func (cr *CatalogReader) DateTimeFormatter() *DateTimeFormatter {
+	if cr == nil {
+		return NewDateTimeFormatter("")
+	}
+	return NewDateTimeFormatter(cr.locale)
+}
+
+// FormatDate returns the date of the time formatted in the style for the reader locale
+func (cr *CatalogReader) FormatDate(t time.Time, style DateTimeStyle) string {
+	return cr.DateTimeFormatter().WithDateStyle(style).Format(t)
+}
+
+// FormatTime returns the time of day formatted in the style for the reader locale
+func (cr *CatalogReader) FormatTime(t time.Time, style DateTimeStyle) string {
+	return cr.DateTimeFormatter().WithDateStyle(DateTimeNone).WithTimeStyle(style).Format(t)
+}
+
+// FormatDateTime returns the date and time formatted in the date and time styles for the reader locale
+func (cr *CatalogReader) FormatDateTime(t time.Time, dateStyle DateTimeStyle, timeStyle DateTimeStyle) string {
+	return cr.DateTimeFormatter().WithDateStyle(dateStyle).WithTimeStyle(timeStyle).Format(t)
+}
+
+// FormatSkeleton returns the time formatted with the fields of the CLDR skeleton (e.g. 'yMMMd' or 'jm') for the
+// reader locale
+func (cr *CatalogReader) FormatSkeleton(t time.Time, skeleton string) string {
+	return cr.DateTimeFormatter().WithSkeleton(skeleton).Format(t)
+}
+
+// RelativeTimeFormatter returns a new RelativeTimeFormatter for the reader locale
+func (cr *CatalogReader) RelativeTimeFormatter() *RelativeTimeFormatter {
+	if cr == nil {
+		return NewRelativeTimeFormatter("")
+	}
+	return NewRelativeTimeFormatter(cr.locale)
+}
+
+// FormatRelativeTime returns the time relative to now for the reader locale, e.g. '3 minutes ago' or 'in 2 days'
+func (cr *CatalogReader) FormatRelativeTime(t time.Time) string {
+	return cr.RelativeTimeFormatter().FormatTime(t)
+}
+
+// DurationFormatter returns a new DurationFormatter for the reader locale
+func (cr *CatalogReader) DurationFormatter() *DurationFormatter {
+	if cr == nil {
+		return NewDurationFormatter("")
+	}
+	return NewDurationFormatter(cr.locale)
+}
+
+// FormatDuration returns the duration formatted as long units for the reader locale, e.g. '2 hours, 5 minutes'
+func (cr *CatalogReader) FormatDuration(d time.Duration) string {
+	return cr.DurationFormatter().Format(d)
+}
+
+// ListFormatter returns a new ListFormatter for the reader locale
+func (cr *CatalogReader) ListFormatter() *ListFormatter {
+	if cr == nil {
+		return NewListFormatter("")
+	}
+	return NewListFormatter(cr.locale)
+}
+
+// FormatList returns the items joined with the conjunction of the reader locale, e.g. 'a, b, and c'
+func (cr *CatalogReader) FormatList(items []string) string {
+	return cr.ListFormatter().Format(items)
+}
+
+// DisplayNames returns new DisplayNames in the reader locale
+func (cr *CatalogReader) DisplayNames() *DisplayNames {
+	if cr == nil {
+		return NewDisplayNames("")
+	}
+	return NewDisplayNames(cr.locale)
+}
+
+// LocaleNames returns the names of the loaded locales of the catalog in the reader locale and in each locale,
+// capitalized for menus
+func (cr *CatalogReader) LocaleNames() []LocaleName {
+	if cr == nil {
+		return []LocaleName{}
+	}
+	names := cr.DisplayNames().WithDisplayContext(DisplayStandalone).LocaleNames(cr.catalog.LoadedLocales())
+	for i, name := range names {
+		if info, exists := cr.catalog.Registry().Lookup(name.Locale); exists && info.NativeName != "" {
+			names[i].NativeName = info.NativeName
+		}
+	}
+	return names
+}
+
+// Collator returns a new Collator for the reader locale
+func (cr *CatalogReader) Collator() *Collator {
+	if cr == nil {
+		return NewCollator("")
+	}
+	return NewCollator(cr.locale)
+}
+
+// SortKeys sorts the keys by their values in the reader locale using its collator, e.g. to order the country names
+// of a menu
+func (cr *CatalogReader) SortKeys(keys []string) {
+	collator := cr.Collator()
+	sortKeys := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		sortKeys[key] = collator.Key(cr.Get(key).Value())
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return bytes.Compare(sortKeys[keys[i]], sortKeys[keys[j]]) < 0
+	})
+}
+
+// CaseMapper returns a new CaseMapper for the reader locale
+func (cr *CatalogReader) CaseMapper() *CaseMapper {
+	if cr == nil {
+		return NewCaseMapper("")
+	}
+	return NewCaseMapper(cr.locale)
+}
+
+// Upper returns the text in upper case for the reader locale, e.g. 'İPTAL' for 'iptal' in turkish
+func (cr *CatalogReader) Upper(text string) string {
+	return cr.CaseMapper().Upper(text)
+}
+
+// Lower returns the text in lower case for the reader locale
+func (cr *CatalogReader) Lower(text string) string {
+	return cr.CaseMapper().Lower(text)
+}
+
+// Title returns the text with the first letter of each word in title case for the reader locale
+func (cr *CatalogReader) Title(text string) string {
+	return cr.CaseMapper().Title(text)
+}
+
+// Sentence returns the text with its first letter in title case and the others in lower case for the reader locale
+func (cr *CatalogReader) Sentence(text string) string {
+	return cr.CaseMapper().Sentence(text)
+}
+
+// CaseFuncs returns the 'upper', 'lower', 'title' and 'sentence' template functions for the reader locale
+func (cr *CatalogReader) CaseFuncs() map[string]any {
+	return cr.CaseMapper().CaseFuncs()
+}
+
+// LocaleInfo returns the metadata of the reader locale from the catalog registry, or derived from the CLDR data
+func (cr *CatalogReader) LocaleInfo() LocaleInfo {
+	var catalog *Catalog
+	locale := ""
+	if cr != nil {
+		catalog, locale = cr.catalog, cr.locale
+	}
+	return catalog.LocaleInfo(locale)
+}
+
+// Direction returns the writing direction of the reader locale, e.g. DirectionRTL for arabic
+func (cr *CatalogReader) Direction() Direction {
+	if cr == nil {
+		return LocaleDirection("")
+	}
+	return cr.catalog.direction(cr.locale)
+}
+
+// WithMissingKeyHandler sets the handler used when a key can not be found, overriding the catalog handler
+func (cr *CatalogReader) WithMissingKeyHandler(handler MissingKeyHandler) *CatalogReader {
+	if cr != nil {
+		cr.missingKeyHandler = handler
+	}
+	return cr
+}
+
+// WithBidiIsolation sets how Format and FormatMessage isolate the arguments holding text of a direction other than
+// the reader direction, e.g. BidiIsolationUnicode for an arabic name in an english message
+func (cr *CatalogReader) WithBidiIsolation(isolation BidiIsolation) *CatalogReader {
+	if cr != nil {
+		cr.bidiIsolation = isolation
+	}
+	return cr
 }
 
 // WithCatalog sets the catalog to use for the catalog reader to the specified catalog
@@ -65,6 +438,14 @@ func (cr *CatalogReader) WithLocale(locale string) *CatalogReader {
 	return cr
 }
 
+// Locale returns the locale of the catalog reader
+func (cr *CatalogReader) Locale() string {
+	if cr == nil {
+		return ""
+	}
+	return cr.locale
+}
+
 // WithContext will add, and return, the catalog reader to the specified context
 func (cr *CatalogReader) WithContext(ctx context.Context) (*CatalogReader, context.Context) {
 	switch {
@@ -81,12 +462,13 @@ func (cr *CatalogReader) WithNewContext() (*CatalogReader, context.Context) {
 	return cr.WithContext(context.Background())
 }
 
-// CatalogReaderFromContext will return the catalog reader contained in the specified context
+// CatalogReaderFromContext will return the catalog reader contained in the specified context, or nil if there is none
 func CatalogReaderFromContext(ctx context.Context) *CatalogReader {
 	switch {
 	case ctx == nil:
 		return nil
 	default:
-		return ctx.Value(CatalogReaderContextKey).(*CatalogReader)
+		reader, _ := ctx.Value(CatalogReaderContextKey).(*CatalogReader)
+		return reader
 	}
 }
@@ -0,0 +1,432 @@
+package i18n
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CollationStrength selects the level of differences a Collator considers
+type CollationStrength int
+
+const (
+	// CollationPrimary compares base letters only, e.g. 'a' = 'á' = 'A'
+	CollationPrimary CollationStrength = iota + 1
+	// CollationSecondary also compares accents, e.g. 'a' < 'á' but 'a' = 'A'
+	CollationSecondary
+	// CollationTertiary also compares case and variants, e.g. 'a' < 'A' < 'á'
+	CollationTertiary
+	// CollationIdentical also compares the code points of strings that are otherwise equal
+	CollationIdentical
+)
+
+//go:embed data/decompositions.txt
+var decompositionsData string
+
+//go:embed data/collation.txt
+var collationData string
+
+// collationElement holds the primary (base letter), secondary (accent) and tertiary (case) weights of a character
+type collationElement struct {
+	primary   uint32
+	secondary uint16
+	tertiary  uint8
+}
+
+const (
+	collationCommon       = 0x05
+	collationUpper        = 0x1d
+	collationOtherDigit   = 0x07
+	collationKatakana     = 0x0f
+	collationBeforeOffset = 0x40
+	collationRootOffset   = 0x80
+)
+
+// collation primary weights of the character groups, from the variable whitespace and punctuation to the letters;
+// letters are spaced by 256 to leave room for tailorings
+const (
+	collationWhitespace  = 0x00100000
+	collationPunctuation = 0x00300000
+	collationSymbol      = 0x00500000
+	collationCurrency    = 0x00700000
+	collationDigit       = 0x00900000
+	collationNumber      = 0x00a00000
+	collationLetter      = 0x01000000
+	collationOther       = 0xf0000000
+)
+
+// collationMarks lists the common combining marks in the order of their secondary weights
+const collationMarks = "\u0301\u0300\u0306\u0302\u030c\u030a\u0308\u030b\u0303\u0307\u0327\u0328\u0304"
+
+var (
+	collationOnce         sync.Once
+	decompositions        map[rune][]rune
+	collationRules        map[string][]string // '<locale>[/<type>]' -> rules
+	collationTailorings   sync.Map            // '<locale>[/<type>]' -> *collationTailoring
+	_collationTokensRegex = regexp.MustCompile(`\[[^\]]*\]|&|<<<|<<|<|=|[^\s&<=\[]+`)
+)
+
+func loadCollationData() {
+	decompositions = make(map[rune][]rune)
+	collationRules = make(map[string][]string)
+
+	scanner := bufio.NewScanner(strings.NewReader(decompositionsData))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			panic(fmt.Sprintf("i18n: invalid decomposition data line '%s'", line))
+		}
+
+		runes := make([]rune, len(fields))
+		for i, field := range fields {
+			cp, err := strconv.ParseUint(field, 16, 32)
+			if err != nil {
+				panic(fmt.Sprintf("i18n: invalid decomposition data line '%s'", line))
+			}
+			runes[i] = rune(cp)
+		}
+		decompositions[runes[0]] = runes[1:]
+	}
+
+	scanner = bufio.NewScanner(strings.NewReader(collationData))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := _numberDataRegex.FindAllString(line, -1)
+		if len(fields) != 2 {
+			panic(fmt.Sprintf("i18n: invalid collation data line '%s'", line))
+		}
+		rules, err := strconv.Unquote(fields[1])
+		if err != nil {
+			panic(fmt.Sprintf("i18n: invalid collation data field '%s': %v", fields[1], err))
+		}
+		collationRules[fields[0]] = append(collationRules[fields[0]], rules)
+	}
+}
+
+// decompose returns the runes of the text in their canonical decomposition, e.g. 'e' and U+0301 for 'é'
+func decompose(text string) []rune {
+	runes := make([]rune, 0, len(text))
+	for _, r := range text {
+		if decomposition, exists := decompositions[r]; exists {
+			runes = append(runes, decomposition...)
+		} else {
+			runes = append(runes, r)
+		}
+	}
+	return runes
+}
+
+// rootCollationElements returns the collation elements of a character without tailoring, ordering whitespace,
+// punctuation, symbols, currencies, digits and letters; control and format characters are ignored
+func rootCollationElements(r rune) []collationElement {
+	switch {
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		weight := uint16(0x0100 + r%0xfe00)
+		if index := strings.IndexRune(collationMarks, r); index >= 0 {
+			weight = uint16(0x20 + utf8.RuneCountInString(collationMarks[:index]))
+		}
+		return []collationElement{{secondary: weight, tertiary: collationCommon}}
+	case unicode.IsSpace(r):
+		return []collationElement{{primary: collationWhitespace + uint32(r), secondary: collationCommon, tertiary: collationCommon}}
+	case unicode.In(r, unicode.Cc, unicode.Cf):
+		return nil
+	case unicode.IsPunct(r):
+		return []collationElement{{primary: collationPunctuation + uint32(r), secondary: collationCommon, tertiary: collationCommon}}
+	case unicode.Is(unicode.Sc, r):
+		return []collationElement{{primary: collationCurrency + uint32(r), secondary: collationCommon, tertiary: collationCommon}}
+	case unicode.IsSymbol(r):
+		return []collationElement{{primary: collationSymbol + uint32(r), secondary: collationCommon, tertiary: collationCommon}}
+	case unicode.IsDigit(r):
+		// decimal digits are encoded in contiguous runs of ten starting at zero
+		zero := r
+		for unicode.IsDigit(zero - 1) {
+			zero--
+		}
+		tertiary := uint8(collationCommon)
+		if r > unicode.MaxASCII {
+			tertiary = collationOtherDigit
+		}
+		return []collationElement{{primary: collationDigit + uint32((r-zero)%10)<<8, secondary: collationCommon, tertiary: tertiary}}
+	case unicode.IsNumber(r):
+		return []collationElement{{primary: collationNumber + uint32(r), secondary: collationCommon, tertiary: collationCommon}}
+	case r >= 0x30a1 && r <= 0x30f6:
+		// katakana sort with the corresponding hiragana
+		return []collationElement{{primary: collationLetter + uint32(r-0x60)<<8, secondary: collationCommon, tertiary: collationKatakana}}
+	case unicode.IsLetter(r):
+		tertiary := uint8(collationCommon)
+		if unicode.IsUpper(r) || unicode.IsTitle(r) {
+			tertiary = collationUpper
+		}
+		return []collationElement{{primary: collationLetter + uint32(unicode.ToLower(r))<<8, secondary: collationCommon, tertiary: tertiary}}
+	default:
+		return []collationElement{{primary: collationOther + uint32(r), secondary: collationCommon, tertiary: collationCommon}}
+	}
+}
+
+// collationTailoring holds the collation elements of the characters and contractions tailored by CLDR rules
+type collationTailoring struct {
+	elements  map[string][]collationElement
+	maxLength int
+	backwards bool
+}
+
+// collationTailoringFor returns the tailoring of the locale, built from the root rules followed by the rules of the
+// locale or of its nearest parent having rules, using the collation type of a '-u-co-<type>' extension if any
+func collationTailoringFor(locale string) *collationTailoring {
+	collationOnce.Do(loadCollationData)
+
+	collationType := ""
+	if base, extension, found := strings.Cut(strings.ToLower(strings.ReplaceAll(locale, "_", "-")), "-u-"); found {
+		fields := strings.Split(extension, "-")
+		for i := 0; i+1 < len(fields); i++ {
+			if fields[i] == "co" {
+				collationType = fields[i+1]
+			}
+		}
+		locale = base
+	}
+
+	key := "root"
+	for _, candidate := range localeCandidates(locale) {
+		if _, exists := collationRules[candidate+"/"+collationType]; exists && collationType != "" {
+			key = candidate + "/" + collationType
+			break
+		}
+		if _, exists := collationRules[candidate]; exists {
+			key = candidate
+			break
+		}
+	}
+
+	if tailoring, exists := collationTailorings.Load(key); exists {
+		return tailoring.(*collationTailoring)
+	}
+
+	tailoring := &collationTailoring{elements: make(map[string][]collationElement)}
+	for _, rules := range collationRules["root"] {
+		tailoring.apply(rules, collationRootOffset)
+	}
+	if key != "root" {
+		for _, rules := range collationRules[key] {
+			tailoring.apply(rules, 0)
+		}
+	}
+
+	collationTailorings.Store(key, tailoring)
+	return tailoring
+}
+
+// apply adds the items of the rules to the tailoring; the first item placed with a primary difference after a reset
+// is offset by the specified amount, leaving room for the items of more specific rules
+func (t *collationTailoring) apply(rules string, offset uint32) {
+	var last []collationElement
+	reset, before := false, false
+	relation, gap := "", offset
+
+	for _, token := range _collationTokensRegex.FindAllString(rules, -1) {
+		switch token {
+		case "[backwards 2]":
+			t.backwards = true
+			continue
+		case "[before 1]":
+			before = true
+			continue
+		case "&":
+			reset = true
+			continue
+		case "<", "<<", "<<<", "=":
+			relation = token
+			continue
+		}
+
+		if reset {
+			last = t.collationElements(decompose(token))
+			if before && len(last) > 0 {
+				last[len(last)-1].primary -= collationBeforeOffset
+			}
+			reset, before, relation, gap = false, false, "", offset
+			continue
+		}
+		if relation == "" || len(last) == 0 {
+			panic(fmt.Sprintf("i18n: invalid collation rules '%s'", rules))
+		}
+
+		elements := append([]collationElement{}, last...)
+		element := &elements[len(elements)-1]
+		tertiary := uint8(collationCommon)
+		if strings.ToLower(token) != token {
+			tertiary = collationUpper
+		}
+
+		switch relation {
+		case "<":
+			element.primary += gap + 1
+			element.secondary, element.tertiary = collationCommon, tertiary
+			gap = 0
+		case "<<":
+			element.secondary++
+			element.tertiary = tertiary
+		case "<<<":
+			element.tertiary = max(element.tertiary+1, tertiary)
+		}
+
+		decomposed := decompose(token)
+		t.elements[string(decomposed)] = elements
+		t.maxLength = max(t.maxLength, len(decomposed))
+		last = elements
+	}
+}
+
+// collationElements returns the collation elements of the decomposed runes, matching the longest tailored
+// contractions first, then their lower case form with upper case weights, and finally the root elements
+func (t *collationTailoring) collationElements(runes []rune) []collationElement {
+	elements := make([]collationElement, 0, len(runes))
+	for i := 0; i < len(runes); {
+		matched := false
+		for n := min(t.maxLength, len(runes)-i); n > 0 && !matched; n-- {
+			candidate := string(runes[i : i+n])
+			if tailored, exists := t.elements[candidate]; exists {
+				elements = append(elements, tailored...)
+				i, matched = i+n, true
+			} else if tailored, exists := t.elements[strings.ToLower(candidate)]; exists {
+				for _, element := range tailored {
+					element.tertiary = collationUpper
+					elements = append(elements, element)
+				}
+				i, matched = i+n, true
+			}
+		}
+
+		if !matched {
+			elements = append(elements, rootCollationElements(runes[i])...)
+			i++
+		}
+	}
+	return elements
+}
+
+// Collator compares and sorts strings following the Unicode Collation Algorithm with the CLDR tailorings of a
+// locale, e.g. 'ä' after 'z' in swedish or 'ç' after 'c' in turkish
+type Collator struct {
+	tailoring         *collationTailoring
+	strength          CollationStrength
+	ignorePunctuation bool
+}
+
+// NewCollator returns a new tertiary Collator for the specified locale; a '-u-co-<type>' extension selects a
+// collation type such as 'de-u-co-phonebk' or 'es-u-co-traditional'
+func NewCollator(locale string) *Collator {
+	return &Collator{
+		tailoring: collationTailoringFor(locale),
+		strength:  CollationTertiary,
+	}
+}
+
+// WithStrength sets the level of differences considered, CollationTertiary by default
+func (c *Collator) WithStrength(strength CollationStrength) *Collator {
+	if c != nil && strength >= CollationPrimary && strength <= CollationIdentical {
+		c.strength = strength
+	}
+	return c
+}
+
+// WithIgnorePunctuation ignores whitespace and punctuation below the identical strength, e.g. 'e-mail' = 'email'
+// at tertiary strength
+func (c *Collator) WithIgnorePunctuation(ignorePunctuation bool) *Collator {
+	if c != nil {
+		c.ignorePunctuation = ignorePunctuation
+	}
+	return c
+}
+
+// Key returns the sort key of the text; comparing sort keys with bytes.Compare is equivalent to Compare
+func (c *Collator) Key(text string) []byte {
+	if c == nil {
+		return []byte(text)
+	}
+
+	runes := decompose(text)
+	elements := c.tailoring.collationElements(runes)
+
+	key := make([]byte, 0, len(elements)*7+16)
+	for _, element := range elements {
+		if c.ignored(element) || element.primary == 0 {
+			continue
+		}
+		key = append(key, byte(element.primary>>24), byte(element.primary>>16), byte(element.primary>>8), byte(element.primary))
+	}
+
+	if c.strength >= CollationSecondary {
+		key = append(key, 0, 0, 0, 0)
+		secondaries := make([]uint16, 0, len(elements))
+		for _, element := range elements {
+			if !c.ignored(element) && element.secondary != 0 {
+				secondaries = append(secondaries, element.secondary)
+			}
+		}
+		if c.tailoring.backwards {
+			for i, j := 0, len(secondaries)-1; i < j; i, j = i+1, j-1 {
+				secondaries[i], secondaries[j] = secondaries[j], secondaries[i]
+			}
+		}
+		for _, secondary := range secondaries {
+			key = append(key, byte(secondary>>8), byte(secondary))
+		}
+	}
+
+	if c.strength >= CollationTertiary {
+		key = append(key, 0, 0)
+		for _, element := range elements {
+			if !c.ignored(element) && element.tertiary != 0 {
+				key = append(key, element.tertiary)
+			}
+		}
+	}
+
+	if c.strength >= CollationIdentical {
+		key = append(key, 0)
+		key = append(key, string(runes)...)
+	}
+	return key
+}
+
+// ignored returns whether the element is ignored as whitespace or punctuation
+func (c *Collator) ignored(element collationElement) bool {
+	return c.ignorePunctuation && element.primary >= collationWhitespace && element.primary < collationSymbol
+}
+
+// Compare returns -1, 0 or 1 whether a sorts before, equal to or after b
+func (c *Collator) Compare(a string, b string) int {
+	return bytes.Compare(c.Key(a), c.Key(b))
+}
+
+// Sort sorts the strings in collation order, keeping the order of equal strings
+func (c *Collator) Sort(items []string) {
+	keys := make(map[string][]byte, len(items))
+	for _, item := range items {
+		if _, exists := keys[item]; !exists {
+			keys[item] = c.Key(item)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return bytes.Compare(keys[items[i]], keys[items[j]]) < 0
+	})
+}
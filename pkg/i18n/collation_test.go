@@ -0,0 +1,80 @@
+package i18n_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestCollatorSort(t *testing.T) {
+	tests := []struct {
+		locale   string
+		items    []string
+		expected []string
+	}{
+		{"en", []string{"zebra", "été", "apple", "Apple", "ete", "éte", "b", "ß", "st", "ss"}, []string{"apple", "Apple", "b", "ete", "éte", "été", "ss", "ß", "st", "zebra"}},
+		{"sv", []string{"öl", "zebra", "år", "äta", "apa", "über", "yz"}, []string{"apa", "über", "yz", "zebra", "år", "äta", "öl"}},
+		{"de", []string{"Müller", "Mufu", "Muller"}, []string{"Mufu", "Muller", "Müller"}},
+		{"de-u-co-phonebk", []string{"Müller", "Mufu", "Muller"}, []string{"Müller", "Mufu", "Muller"}},
+		{"tr", []string{"ırmak", "Irmak", "ilk", "İlk", "hane", "çay", "cep", "dede"}, []string{"cep", "çay", "dede", "hane", "ırmak", "Irmak", "ilk", "İlk"}},
+		{"es-MX", []string{"ñu", "nube", "oso"}, []string{"nube", "ñu", "oso"}},
+		{"da", []string{"Aarhus", "Zürich", "Ærø", "Øst", "Alborg"}, []string{"Alborg", "Zürich", "Ærø", "Øst", "Aarhus"}},
+		{"cs", []string{"chata", "hrad", "ihned", "čaj", "cukr"}, []string{"cukr", "čaj", "hrad", "chata", "ihned"}},
+		{"fr", []string{"côté", "côte", "coté", "cote"}, []string{"cote", "coté", "côte", "côté"}},
+		{"fr-CA", []string{"côté", "côte", "coté", "cote"}, []string{"cote", "côte", "coté", "côté"}},
+		{"ru", []string{"яблоко", "ёж", "еда", "йод", "игра"}, []string{"еда", "ёж", "игра", "йод", "яблоко"}},
+		{"ja", []string{"カ", "か", "ア", "あ"}, []string{"あ", "ア", "か", "カ"}},
+	}
+
+	for _, test := range tests {
+		items := append([]string{}, test.items...)
+		i18n.NewCollator(test.locale).Sort(items)
+		if !reflect.DeepEqual(items, test.expected) {
+			t.Errorf("expected %v in locale '%s' but got %v", test.expected, test.locale, items)
+		}
+	}
+}
+
+func TestCollatorStrength(t *testing.T) {
+	tests := []struct {
+		collator *i18n.Collator
+		a        string
+		b        string
+		expected int
+	}{
+		{i18n.NewCollator("en").WithStrength(i18n.CollationPrimary), "resume", "Résumé", 0},
+		{i18n.NewCollator("en").WithStrength(i18n.CollationSecondary), "resume", "Resume", 0},
+		{i18n.NewCollator("en").WithStrength(i18n.CollationSecondary), "resume", "résumé", -1},
+		{i18n.NewCollator("en"), "resume", "Resume", -1},
+		{i18n.NewCollator("en"), "e-mail", "email", -1},
+		{i18n.NewCollator("en").WithIgnorePunctuation(true), "e-mail", "email", 0},
+		{i18n.NewCollator("en").WithIgnorePunctuation(true).WithStrength(i18n.CollationIdentical), "e-mail", "email", -1},
+		{i18n.NewCollator("en").WithStrength(i18n.CollationIdentical), "é", "é", 0},
+	}
+
+	for _, test := range tests {
+		if v := test.collator.Compare(test.a, test.b); v != test.expected {
+			t.Errorf("expected %d comparing '%s' and '%s' but got %d", test.expected, test.a, test.b, v)
+		}
+	}
+}
+
+func TestCatalogSortedKeys(t *testing.T) {
+	catalog := i18n.NewCatalog()
+	catalog.AddKeyValue("sv", i18n.NewKeyPair("country.at", "Österrike"))
+	catalog.AddKeyValue("sv", i18n.NewKeyPair("country.dk", "Danmark"))
+	catalog.AddKeyValue("sv", i18n.NewKeyPair("country.ax", "Åland"))
+	catalog.AddKeyValue("sv", i18n.NewKeyPair("country.ch", "Schweiz"))
+
+	expected := []string{"country.dk", "country.ch", "country.ax", "country.at"}
+	if v := catalog.SortedKeys("sv"); !reflect.DeepEqual(v, expected) {
+		t.Errorf("expected %v but got %v", expected, v)
+	}
+
+	keys := []string{"country.at", "country.ax", "country.dk"}
+	i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("sv").SortKeys(keys)
+	if expected := []string{"country.dk", "country.ax", "country.at"}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v but got %v", expected, keys)
+	}
+}
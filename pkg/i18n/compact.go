@@ -0,0 +1,178 @@
+package i18n
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Width selects between the long, short and narrow forms of localized text such as units, compact numbers and lists
+type Width int
+
+const (
+	WidthShort Width = iota
+	WidthLong
+	WidthNarrow
+)
+
+func (w Width) String() string {
+	switch w {
+	case WidthLong:
+		return "long"
+	case WidthNarrow:
+		return "narrow"
+	default:
+		return "short"
+	}
+}
+
+const (
+	compactMinimumMagnitude = 3
+	compactMaximumMagnitude = 14
+)
+
+// compactPatterns maps plural categories to the patterns for each magnitude from 10^3 to 10^14
+type compactPatterns map[PluralCategory][]string
+
+//go:embed data/compact.txt
+var compactData string
+
+var (
+	compactOnce         sync.Once
+	compactPatternsData map[string]map[string]compactPatterns // locale -> width -> patterns
+)
+
+func loadCompactPatterns() {
+	compactPatternsData = make(map[string]map[string]compactPatterns)
+
+	scanner := bufio.NewScanner(strings.NewReader(compactData))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := _numberDataRegex.FindAllString(line, -1)
+		if len(fields) != 3+compactMaximumMagnitude-compactMinimumMagnitude+1 {
+			panic(fmt.Sprintf("i18n: invalid compact data line '%s'", line))
+		}
+
+		patterns := make([]string, 0, len(fields)-3)
+		for _, field := range fields[3:] {
+			unquoted, err := strconv.Unquote(field)
+			if err != nil {
+				panic(fmt.Sprintf("i18n: invalid compact data field '%s': %v", field, err))
+			}
+			patterns = append(patterns, unquoted)
+		}
+
+		locale, width := fields[0], fields[1]
+		if compactPatternsData[locale] == nil {
+			compactPatternsData[locale] = make(map[string]compactPatterns)
+		}
+		if compactPatternsData[locale][width] == nil {
+			compactPatternsData[locale][width] = make(compactPatterns)
+		}
+		compactPatternsData[locale][width][PluralCategory(fields[2])] = patterns
+	}
+}
+
+func compactPatternsFor(locale string, width Width) compactPatterns {
+	compactOnce.Do(loadCompactPatterns)
+
+	candidates := append(localeCandidates(locale), "root")
+	if width == WidthLong {
+		for _, candidate := range candidates {
+			if patterns, exists := compactPatternsData[candidate]["long"]; exists {
+				return patterns
+			}
+		}
+	}
+
+	for _, candidate := range candidates {
+		if patterns, exists := compactPatternsData[candidate]["short"]; exists {
+			return patterns
+		}
+	}
+	return nil
+}
+
+// formatCompact formats the value as a compact decimal such as '1.2K' or '3,4 Mio.'
+func (nf *NumberFormatter) formatCompact(value float64) string {
+	abs := math.Abs(value)
+	patterns := compactPatternsFor(nf.locale, nf.width)
+
+	magnitude := 0
+	if abs >= 1 {
+		magnitude = int(math.Floor(math.Log10(abs)))
+	}
+
+	for {
+		if magnitude < compactMinimumMagnitude || patterns[PluralOther] == nil {
+			return nf.formatPattern(value, nf.symbols.decimalPattern, 0, nf.compactFractionDigits(abs))
+		}
+
+		pattern := patterns[PluralOther][min(magnitude, compactMaximumMagnitude)-compactMinimumMagnitude]
+		zeros := strings.Count(pattern, "0")
+		if strings.Trim(pattern, "0") == "" {
+			// uncompacted numbers are only grouped from five integer digits, e.g. '1234' but '12.345'
+			uncompacted := *nf
+			uncompacted.grouping = nf.grouping && magnitude > 3
+			return uncompacted.formatPattern(value, nf.symbols.decimalPattern, 0, 0)
+		}
+
+		exponent := min(magnitude, compactMaximumMagnitude) - zeros + 1
+		scaled := abs / math.Pow10(exponent)
+
+		maximumFraction := nf.compactFractionDigits(scaled)
+		minimumFraction, _ := nf.fractionDigits(0, maximumFraction)
+
+		display := strconv.FormatFloat(scaled, 'f', maximumFraction, 64)
+		if f, _ := strconv.ParseFloat(display, 64); f >= math.Pow10(zeros) && magnitude < compactMaximumMagnitude {
+			magnitude++
+			continue
+		}
+
+		grouping := numberPattern{}
+		if zeros > 3 {
+			grouping = nf.symbols.decimalPattern
+		}
+		number, zero := nf.formatDecimal(scaled, grouping, minimumFraction, maximumFraction)
+
+		if strings.Contains(display, ".") {
+			display = strings.TrimRight(strings.TrimRight(display, "0"), ".")
+		}
+		// the plural category is selected by the number as displayed along with its compact exponent
+		ops, _ := NewPluralOperands(display)
+		ops.E = exponent
+		if categoryPatterns, exists := patterns[CardinalRules(nf.locale).SelectOperands(ops)]; exists {
+			pattern = categoryPatterns[min(magnitude, compactMaximumMagnitude)-compactMinimumMagnitude]
+		}
+
+		start := strings.Index(pattern, "0")
+		end := strings.LastIndex(pattern, "0") + 1
+		formatted := pattern[:start] + number + pattern[end:]
+
+		if value < 0 && !zero {
+			return nf.symbols.minus + formatted
+		}
+		return formatted
+	}
+}
+
+// compactFractionDigits returns the maximum fraction digits for a compact number, keeping two significant digits for
+// numbers with a single integer digit unless the fraction digits were set explicitly
+func (nf *NumberFormatter) compactFractionDigits(scaled float64) int {
+	switch {
+	case nf.fractionSet:
+		return nf.maximumFraction
+	case scaled < 10:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,70 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestCompactFormatter(t *testing.T) {
+	tests := []struct {
+		formatter *i18n.NumberFormatter
+		number    any
+		expected  string
+	}{
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberCompact), 999, "999"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberCompact), 1234, "1.2K"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberCompact), 12345, "12K"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberCompact), 999999, "1M"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberCompact), -1500000, "-1.5M"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberCompact).WithWidth(i18n.WidthLong), 1234, "1.2 thousand"},
+		{i18n.NewNumberFormatter("de").WithStyle(i18n.NumberCompact), 3400000, "3,4\u00a0Mio."},
+		{i18n.NewNumberFormatter("de").WithStyle(i18n.NumberCompact), 1234, "1234"},
+		{i18n.NewNumberFormatter("de").WithStyle(i18n.NumberCompact).WithWidth(i18n.WidthLong), 1000000, "1 Million"},
+		{i18n.NewNumberFormatter("de").WithStyle(i18n.NumberCompact).WithWidth(i18n.WidthLong), 2000000, "2 Millionen"},
+		{i18n.NewNumberFormatter("ru").WithStyle(i18n.NumberCompact).WithWidth(i18n.WidthLong), 5000000, "5 миллионов"},
+		{i18n.NewNumberFormatter("ja").WithStyle(i18n.NumberCompact), 123456, "12万"},
+		{i18n.NewNumberFormatter("it").WithStyle(i18n.NumberCompact).WithWidth(i18n.WidthLong), 2000000, "2 Mln"},
+	}
+
+	for _, test := range tests {
+		if v := test.formatter.Format(test.number); v != test.expected {
+			t.Errorf("expected '%s' for %v but got '%s'", test.expected, test.number, v)
+		}
+	}
+}
+
+func TestByteSizeFormatter(t *testing.T) {
+	tests := []struct {
+		formatter *i18n.NumberFormatter
+		number    any
+		expected  string
+	}{
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberByteSize), 512, "512 byte"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberByteSize), 1500000000, "1.5 GB"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberBinaryByteSize), 1536, "1.5 KiB"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberByteSize).WithWidth(i18n.WidthLong), 1, "1 byte"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberByteSize).WithWidth(i18n.WidthLong), 2000, "2 kilobytes"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberByteSize).WithWidth(i18n.WidthNarrow), 2000, "2kB"},
+		{i18n.NewNumberFormatter("fr").WithStyle(i18n.NumberByteSize), 1500000000, "1,5\u00a0Go"},
+		{i18n.NewNumberFormatter("ru").WithStyle(i18n.NumberByteSize).WithWidth(i18n.WidthLong), 5000000, "5 мегабайт"},
+		{i18n.NewNumberFormatter("ru").WithStyle(i18n.NumberByteSize).WithWidth(i18n.WidthNarrow), 5000000, "5 МБ"},
+		{i18n.NewNumberFormatter("de").WithStyle(i18n.NumberByteSize), 999999, "1 MB"},
+	}
+
+	for _, test := range tests {
+		if v := test.formatter.Format(test.number); v != test.expected {
+			t.Errorf("expected '%s' for %v but got '%s'", test.expected, test.number, v)
+		}
+	}
+}
+
+func TestFormatUnit(t *testing.T) {
+	if v := i18n.NewNumberFormatter("en").WithWidth(i18n.WidthLong).FormatUnit(1, "kilobyte"); v != "1 kilobyte" {
+		t.Errorf("expected '1 kilobyte' but got '%s'", v)
+	}
+
+	if v := i18n.NewCatalogReader().WithLocale("en").FormatCompact(1234); v != "1.2K" {
+		t.Errorf("expected '1.2K' but got '%s'", v)
+	}
+}
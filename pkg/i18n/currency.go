@@ -0,0 +1,182 @@
+package i18n
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// CurrencyDisplay determines how the currency is shown by a NumberFormatter
+type CurrencyDisplay int
+
+const (
+	CurrencyDisplaySymbol CurrencyDisplay = iota
+	CurrencyDisplayNarrowSymbol
+	CurrencyDisplayCode
+)
+
+type currencyInfo struct {
+	digits       int
+	symbol       string
+	narrowSymbol string
+}
+
+type currencyPatternSet struct {
+	standard   numberPattern
+	accounting numberPattern
+}
+
+//go:embed data/currencies.txt
+var currenciesData string
+
+var (
+	currencyOnce     sync.Once
+	currencyData     map[string]currencyInfo
+	currencySymbols  map[string]map[string]string // locale -> code -> symbol
+	currencyPatterns map[string]currencyPatternSet
+)
+
+func loadCurrencies() {
+	currencyData = make(map[string]currencyInfo)
+	currencySymbols = make(map[string]map[string]string)
+	currencyPatterns = make(map[string]currencyPatternSet)
+
+	scanner := bufio.NewScanner(strings.NewReader(currenciesData))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := _numberDataRegex.FindAllString(line, -1)
+		for i, field := range fields {
+			if strings.HasPrefix(field, `"`) {
+				unquoted, err := strconv.Unquote(field)
+				if err != nil {
+					panic(fmt.Sprintf("i18n: invalid currency data field '%s': %v", field, err))
+				}
+				fields[i] = unquoted
+			}
+		}
+
+		switch {
+		case fields[0] == "currency" && len(fields) == 5:
+			digits, err := strconv.Atoi(fields[2])
+			if err != nil {
+				panic(fmt.Sprintf("i18n: invalid currency data line '%s'", line))
+			}
+			currencyData[fields[1]] = currencyInfo{digits: digits, symbol: fields[3], narrowSymbol: fields[4]}
+		case fields[0] == "symbol" && len(fields) == 4:
+			if currencySymbols[fields[1]] == nil {
+				currencySymbols[fields[1]] = make(map[string]string)
+			}
+			currencySymbols[fields[1]][fields[2]] = fields[3]
+		case fields[0] == "pattern" && len(fields) == 4:
+			currencyPatterns[fields[1]] = currencyPatternSet{
+				standard:   parseNumberPattern(fields[2]),
+				accounting: parseNumberPattern(fields[3]),
+			}
+		default:
+			panic(fmt.Sprintf("i18n: invalid currency data line '%s'", line))
+		}
+	}
+}
+
+// CurrencyDigits returns the number of minor digits of the ISO 4217 currency code, or 2 for unknown currencies
+func CurrencyDigits(code string) int {
+	currencyOnce.Do(loadCurrencies)
+
+	if info, exists := currencyData[strings.ToUpper(code)]; exists {
+		return info.digits
+	}
+	return 2
+}
+
+// CurrencySymbol returns the symbol of the ISO 4217 currency code in the specified locale, or the code for unknown
+// currencies
+func CurrencySymbol(locale string, code string) string {
+	currencyOnce.Do(loadCurrencies)
+
+	code = strings.ToUpper(code)
+	for _, candidate := range localeCandidates(locale) {
+		if symbol, exists := currencySymbols[candidate][code]; exists {
+			return symbol
+		}
+	}
+
+	if info, exists := currencyData[code]; exists {
+		return info.symbol
+	}
+	return code
+}
+
+// currencyNarrowSymbol returns the narrow symbol of the ISO 4217 currency code, or the code for unknown currencies
+func currencyNarrowSymbol(code string) string {
+	currencyOnce.Do(loadCurrencies)
+
+	if info, exists := currencyData[strings.ToUpper(code)]; exists {
+		return info.narrowSymbol
+	}
+	return strings.ToUpper(code)
+}
+
+func currencyPatternsFor(locale string) currencyPatternSet {
+	currencyOnce.Do(loadCurrencies)
+
+	for _, candidate := range localeCandidates(locale) {
+		if patterns, exists := currencyPatterns[candidate]; exists {
+			return patterns
+		}
+	}
+	return currencyPatterns["root"]
+}
+
+// WithCurrency sets the ISO 4217 currency code and switches the formatter to the NumberCurrency style unless the
+// NumberAccounting style is already set
+func (nf *NumberFormatter) WithCurrency(code string) *NumberFormatter {
+	if nf != nil {
+		nf.currency = strings.ToUpper(code)
+		if nf.style != NumberAccounting {
+			nf.style = NumberCurrency
+		}
+	}
+	return nf
+}
+
+// WithCurrencyDisplay sets how the currency is shown: symbol, narrow symbol or ISO 4217 code
+func (nf *NumberFormatter) WithCurrencyDisplay(display CurrencyDisplay) *NumberFormatter {
+	if nf != nil {
+		nf.currencyDisplay = display
+	}
+	return nf
+}
+
+func (nf *NumberFormatter) currencySymbol() string {
+	if nf.style != NumberCurrency && nf.style != NumberAccounting {
+		return ""
+	}
+
+	switch nf.currencyDisplay {
+	case CurrencyDisplayCode:
+		return nf.currency
+	case CurrencyDisplayNarrowSymbol:
+		return currencyNarrowSymbol(nf.currency)
+	default:
+		return CurrencySymbol(nf.locale, nf.currency)
+	}
+}
+
+func (nf *NumberFormatter) formatCurrency(value float64) string {
+	patterns := currencyPatternsFor(nf.locale)
+
+	pattern := patterns.standard
+	if nf.style == NumberAccounting {
+		pattern = patterns.accounting
+	}
+
+	digits := CurrencyDigits(nf.currency)
+	return nf.formatPattern(value, pattern, digits, digits)
+}
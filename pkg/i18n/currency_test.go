@@ -0,0 +1,57 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestCurrencyFormatter(t *testing.T) {
+	tests := []struct {
+		formatter *i18n.NumberFormatter
+		amount    any
+		expected  string
+	}{
+		{i18n.NewNumberFormatter("en-US").WithCurrency("USD"), 1234.5, "$1,234.50"},
+		{i18n.NewNumberFormatter("en-US").WithCurrency("USD"), -1234.5, "-$1,234.50"},
+		{i18n.NewNumberFormatter("de-DE").WithCurrency("EUR"), 1234.5, "1.234,50\u00a0€"},
+		{i18n.NewNumberFormatter("fr-FR").WithCurrency("EUR"), 1234.5, "1\u202f234,50\u00a0€"},
+		{i18n.NewNumberFormatter("de-CH").WithCurrency("CHF"), -5, "CHF-5.00"},
+		{i18n.NewNumberFormatter("en-US").WithCurrency("JPY"), 1234.5, "¥1,234"},
+		{i18n.NewNumberFormatter("ja").WithCurrency("JPY"), 1234, "￥1,234"},
+		{i18n.NewNumberFormatter("en").WithCurrency("KWD"), 1.5, "KWD\u00a01.500"},
+		{i18n.NewNumberFormatter("en").WithCurrency("CAD"), 3, "CA$3.00"},
+		{i18n.NewNumberFormatter("en-CA").WithCurrency("CAD"), 3, "$3.00"},
+		{i18n.NewNumberFormatter("en").WithCurrency("CAD").WithCurrencyDisplay(i18n.CurrencyDisplayNarrowSymbol), 3, "$3.00"},
+		{i18n.NewNumberFormatter("en").WithCurrency("USD").WithCurrencyDisplay(i18n.CurrencyDisplayCode), 3, "USD\u00a03.00"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberAccounting).WithCurrency("USD"), -3, "($3.00)"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberAccounting).WithCurrency("USD"), 3, "$3.00"},
+		{i18n.NewNumberFormatter("en-IN").WithCurrency("INR"), 1234567, "₹12,34,567.00"},
+		{i18n.NewNumberFormatter("en").WithCurrency("XYZ"), 1, "XYZ\u00a01.00"},
+	}
+
+	for _, test := range tests {
+		if v := test.formatter.Format(test.amount); v != test.expected {
+			t.Errorf("expected '%s' for %v but got '%s'", test.expected, test.amount, v)
+		}
+	}
+}
+
+func TestCurrencyData(t *testing.T) {
+	switch {
+	case i18n.CurrencyDigits("JPY") != 0:
+		t.Errorf("expected 0 digits for JPY but got %d", i18n.CurrencyDigits("JPY"))
+	case i18n.CurrencyDigits("bhd") != 3:
+		t.Errorf("expected 3 digits for BHD but got %d", i18n.CurrencyDigits("bhd"))
+	case i18n.CurrencySymbol("fr-CA", "USD") != "$\u00a0US":
+		t.Errorf("expected '$\u00a0US' for USD in fr-CA but got '%s'", i18n.CurrencySymbol("fr-CA", "USD"))
+	}
+}
+
+func TestReaderFormatCurrency(t *testing.T) {
+	catalogReader := i18n.NewCatalogReader().WithLocale("de-DE")
+
+	if v := catalogReader.FormatCurrency(1234.5, "EUR"); v != "1.234,50\u00a0€" {
+		t.Errorf("expected '1.234,50\u00a0€' but got '%s'", v)
+	}
+}
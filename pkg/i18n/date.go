@@ -0,0 +1,786 @@
+package i18n
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DateTimeStyle selects one of the CLDR date or time format lengths of a locale
+type DateTimeStyle int
+
+const (
+	DateTimeNone DateTimeStyle = iota
+	DateTimeFull
+	DateTimeLong
+	DateTimeMedium
+	DateTimeShort
+)
+
+func (s DateTimeStyle) String() string {
+	switch s {
+	case DateTimeFull:
+		return "full"
+	case DateTimeLong:
+		return "long"
+	case DateTimeMedium:
+		return "medium"
+	case DateTimeShort:
+		return "short"
+	default:
+		return "none"
+	}
+}
+
+// HourCycle overrides the 12 or 24-hour clock preferred by a locale
+type HourCycle int
+
+const (
+	HourCycleLocale HourCycle = iota
+	HourCycle12
+	HourCycle24
+)
+
+const (
+	dateFieldLetters = "GyYuMLdDEcw"
+	zoneFieldLetters = "zZOvVxX"
+	dateSpaces       = " \u00a0\u202f"
+)
+
+//go:embed data/dates.txt
+var datesData string
+
+var (
+	datesOnce     sync.Once
+	dateFieldData map[string]map[string][]string // locale -> field -> values
+)
+
+func loadDateFields() {
+	dateFieldData = make(map[string]map[string][]string)
+
+	scanner := bufio.NewScanner(strings.NewReader(datesData))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := _numberDataRegex.FindAllString(line, -1)
+		if len(fields) < 3 {
+			panic(fmt.Sprintf("i18n: invalid date data line '%s'", line))
+		}
+
+		values := make([]string, 0, len(fields)-2)
+		for _, field := range fields[2:] {
+			value, err := strconv.Unquote(field)
+			if err != nil {
+				panic(fmt.Sprintf("i18n: invalid date data field '%s': %v", field, err))
+			}
+			values = append(values, value)
+		}
+
+		if dateFieldData[fields[0]] == nil {
+			dateFieldData[fields[0]] = make(map[string][]string)
+		}
+		dateFieldData[fields[0]][fields[1]] = values
+	}
+}
+
+// dateField returns the values of the calendar field for the locale, falling back to its parents and finally to 'root'
+func dateField(locale string, field string) []string {
+	datesOnce.Do(loadDateFields)
+
+	for _, candidate := range append(localeCandidates(locale), "root") {
+		if values, exists := dateFieldData[candidate][field]; exists {
+			return values
+		}
+	}
+	return nil
+}
+
+// dateFieldValue returns the single value of the calendar field for the locale
+func dateFieldValue(locale string, field string) string {
+	if values := dateField(locale, field); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// dateToken is either a pattern field such as 'MMM' or a literal text
+type dateToken struct {
+	field   byte
+	count   int
+	literal string
+}
+
+// parseDatePattern splits a CLDR date pattern such as "EEEE, d 'de' MMMM" into fields and literals
+func parseDatePattern(pattern string) []dateToken {
+	var tokens []dateToken
+	var literal strings.Builder
+
+	flush := func() {
+		if literal.Len() > 0 {
+			tokens = append(tokens, dateToken{literal: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch {
+		case c == '\'' && i+1 < len(pattern) && pattern[i+1] == '\'':
+			literal.WriteByte('\'')
+			i += 2
+		case c == '\'':
+			for i++; i < len(pattern); i++ {
+				if pattern[i] != '\'' {
+					literal.WriteByte(pattern[i])
+				} else if i+1 < len(pattern) && pattern[i+1] == '\'' {
+					literal.WriteByte('\'')
+					i++
+				} else {
+					break
+				}
+			}
+			i++
+		case isASCIILetter(c):
+			flush()
+			count := 1
+			for i+count < len(pattern) && pattern[i+count] == c {
+				count++
+			}
+			tokens = append(tokens, dateToken{field: c, count: count})
+			i += count
+		default:
+			literal.WriteByte(c)
+			i++
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+// datePatternString returns the pattern of the tokens, quoting literals that contain letters or quotes
+func datePatternString(tokens []dateToken) string {
+	var sb strings.Builder
+	for _, token := range tokens {
+		if token.field != 0 {
+			sb.WriteString(strings.Repeat(string(token.field), token.count))
+			continue
+		}
+		if strings.IndexFunc(token.literal, func(r rune) bool { return r < 0x80 && isASCIILetter(byte(r)) || r == '\'' }) < 0 {
+			sb.WriteString(token.literal)
+			continue
+		}
+		if token.literal == "'" {
+			sb.WriteString("''")
+			continue
+		}
+		sb.WriteString("'" + strings.ReplaceAll(token.literal, "'", "''") + "'")
+	}
+	return sb.String()
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// DateTimeFormatter formats times using the CLDR gregorian calendar data of a locale, either with date and time
+// styles, with a skeleton such as 'yMMMd' or with an explicit CLDR pattern
+type DateTimeFormatter struct {
+	locale  string
+	numbers *NumberFormatter
+
+	dateStyle DateTimeStyle
+	timeStyle DateTimeStyle
+	skeleton  string
+	pattern   string
+	hourCycle HourCycle
+	location  *time.Location
+}
+
+// NewDateTimeFormatter returns a new DateTimeFormatter for the specified locale formatting dates in the medium style
+func NewDateTimeFormatter(locale string) *DateTimeFormatter {
+	return &DateTimeFormatter{
+		locale:    locale,
+		numbers:   NewNumberFormatter(locale),
+		dateStyle: DateTimeMedium,
+	}
+}
+
+// WithDateStyle sets the style of the date part, DateTimeNone omits the date
+func (df *DateTimeFormatter) WithDateStyle(style DateTimeStyle) *DateTimeFormatter {
+	if df != nil {
+		df.dateStyle = style
+		df.skeleton, df.pattern = "", ""
+	}
+	return df
+}
+
+// WithTimeStyle sets the style of the time part, DateTimeNone omits the time
+func (df *DateTimeFormatter) WithTimeStyle(style DateTimeStyle) *DateTimeFormatter {
+	if df != nil {
+		df.timeStyle = style
+		df.skeleton, df.pattern = "", ""
+	}
+	return df
+}
+
+// WithSkeleton sets the fields to display as a CLDR skeleton (e.g. 'yMMMd', 'Hm' or 'jm' for the preferred hour
+// cycle of the locale); the locale decides their order, separators and literals
+func (df *DateTimeFormatter) WithSkeleton(skeleton string) *DateTimeFormatter {
+	if df != nil {
+		df.skeleton, df.pattern = skeleton, ""
+	}
+	return df
+}
+
+// WithPattern sets an explicit CLDR pattern such as "d MMMM y 'at' HH:mm", month and weekday names are still
+// localized
+func (df *DateTimeFormatter) WithPattern(pattern string) *DateTimeFormatter {
+	if df != nil {
+		df.pattern, df.skeleton = pattern, ""
+	}
+	return df
+}
+
+// WithHourCycle overrides the 12 or 24-hour clock of the locale for styles and skeletons
+func (df *DateTimeFormatter) WithHourCycle(hourCycle HourCycle) *DateTimeFormatter {
+	if df != nil {
+		df.hourCycle = hourCycle
+	}
+	return df
+}
+
+// WithLocation converts times to the location before formatting them
+func (df *DateTimeFormatter) WithLocation(location *time.Location) *DateTimeFormatter {
+	if df != nil {
+		df.location = location
+	}
+	return df
+}
+
+// WithNumberingSystem sets the digits to use, see NumberFormatter.WithNumberingSystem
+func (df *DateTimeFormatter) WithNumberingSystem(numberingSystem string) *DateTimeFormatter {
+	if df != nil {
+		df.numbers.WithNumberingSystem(numberingSystem)
+	}
+	return df
+}
+
+// Pattern returns the CLDR pattern used by the formatter
+func (df *DateTimeFormatter) Pattern() string {
+	if df == nil {
+		return ""
+	}
+	return datePatternString(df.tokens())
+}
+
+// Format returns the localized representation of the time
+func (df *DateTimeFormatter) Format(t time.Time) string {
+	if df == nil {
+		return t.String()
+	}
+	if df.location != nil {
+		t = t.In(df.location)
+	}
+
+	var sb strings.Builder
+	for _, token := range df.tokens() {
+		if token.field == 0 {
+			sb.WriteString(token.literal)
+			continue
+		}
+		df.formatField(&sb, t, token)
+	}
+	return sb.String()
+}
+
+func (df *DateTimeFormatter) tokens() []dateToken {
+	switch {
+	case df.pattern != "":
+		return parseDatePattern(df.pattern)
+	case df.skeleton != "":
+		return df.skeletonTokens(df.skeleton)
+	}
+
+	date := dateFieldValue(df.locale, "date."+df.dateStyle.String())
+	clock := df.applyHourCycle(dateFieldValue(df.locale, "time."+df.timeStyle.String()))
+	switch {
+	case df.dateStyle == DateTimeNone && df.timeStyle == DateTimeNone:
+		return nil
+	case df.timeStyle == DateTimeNone:
+		return parseDatePattern(date)
+	case df.dateStyle == DateTimeNone:
+		return parseDatePattern(clock)
+	}
+
+	return parseDatePattern(strings.NewReplacer("{1}", date, "{0}", clock).Replace(dateFieldValue(df.locale, "datetime."+df.dateStyle.String())))
+}
+
+// hourField returns the hour field letter of the effective hour cycle, 'h' or 'H'
+func (df *DateTimeFormatter) hourField() byte {
+	switch df.hourCycle {
+	case HourCycle12:
+		return 'h'
+	case HourCycle24:
+		return 'H'
+	}
+	if dateFieldValue(df.locale, "hourcycle") == "h" {
+		return 'h'
+	}
+	return 'H'
+}
+
+// applyHourCycle rewrites the hours of a locale time pattern for an overridden hour cycle
+func (df *DateTimeFormatter) applyHourCycle(pattern string) string {
+	if df.hourCycle == HourCycleLocale || pattern == "" {
+		return pattern
+	}
+
+	tokens := parseDatePattern(pattern)
+	hour := df.hourField()
+
+	var result []dateToken
+	hasPeriod := false
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		switch token.field {
+		case 'a', 'B':
+			if hour == 'h' {
+				hasPeriod = true
+				break
+			}
+			if last := len(result) - 1; last >= 0 && result[last].field == 0 {
+				result[last].literal = strings.TrimRight(result[last].literal, dateSpaces)
+			} else if i+1 < len(tokens) && tokens[i+1].field == 0 {
+				tokens[i+1].literal = strings.TrimLeft(tokens[i+1].literal, dateSpaces)
+			}
+			continue
+		case 'h', 'H', 'k', 'K':
+			if token.field != hour {
+				token.field, token.count = hour, 1
+				if hour == 'H' {
+					token.count = 2
+				}
+			}
+		}
+		result = append(result, token)
+	}
+
+	if hour == 'h' && !hasPeriod {
+		result = insertDatePeriod(result)
+	}
+	return datePatternString(result)
+}
+
+// insertDatePeriod adds a day period after the last time field of the tokens
+func insertDatePeriod(tokens []dateToken) []dateToken {
+	last := -1
+	for i, token := range tokens {
+		if token.field != 0 && strings.IndexByte("hHkKms", token.field) >= 0 {
+			last = i
+		}
+	}
+	if last < 0 {
+		return tokens
+	}
+
+	result := append([]dateToken{}, tokens[:last+1]...)
+	result = append(result, dateToken{literal: " "}, dateToken{field: 'a', count: 1})
+	return append(result, tokens[last+1:]...)
+}
+
+// skeletonTokens resolves a skeleton to the pattern of the locale with the best matching available format; the era
+// and time zone fields missing from the available formats are appended with the 'append.era' and 'append.zone'
+// patterns of the locale, like the CLDR appendItems
+func (df *DateTimeFormatter) skeletonTokens(skeleton string) []dateToken {
+	requested := normalizeSkeleton(parseDatePattern(skeleton), df.hourField(), df.hourCycle != HourCycleLocale)
+	if len(requested) == 0 {
+		return nil
+	}
+
+	if tokens := df.matchSkeleton(requested); tokens != nil {
+		return tokens
+	}
+
+	var fields, appended []dateToken
+	for _, token := range requested {
+		if token.field == 'G' || strings.IndexByte(zoneFieldLetters, token.field) >= 0 {
+			appended = append(appended, token)
+		} else {
+			fields = append(fields, token)
+		}
+	}
+
+	tokens := df.combineSkeletonTokens(fields)
+	for _, field := range appended {
+		if len(tokens) == 0 {
+			tokens = []dateToken{field}
+			continue
+		}
+
+		item := "append.zone"
+		if field.field == 'G' {
+			item = "append.era"
+		}
+		pattern := strings.NewReplacer("{0}", datePatternString(tokens), "{1}", datePatternString([]dateToken{field})).
+			Replace(dateFieldValue(df.locale, item))
+		tokens = parseDatePattern(pattern)
+	}
+	return tokens
+}
+
+// combineSkeletonTokens resolves the skeleton fields to an available format of the locale or, failing that, combines
+// the available formats of their date and time fields with the date time pattern of the locale
+func (df *DateTimeFormatter) combineSkeletonTokens(fields []dateToken) []dateToken {
+	if len(fields) == 0 {
+		return nil
+	}
+	if tokens := df.matchSkeleton(fields); tokens != nil {
+		return tokens
+	}
+
+	var date, clock []dateToken
+	for _, token := range fields {
+		if strings.IndexByte(dateFieldLetters, token.field) >= 0 {
+			date = append(date, token)
+		} else {
+			clock = append(clock, token)
+		}
+	}
+
+	if len(date) > 0 && len(clock) > 0 {
+		dateTokens, clockTokens := df.matchSkeleton(date), df.matchSkeleton(clock)
+		if dateTokens == nil {
+			dateTokens = fallbackSkeletonTokens(date, " ")
+		}
+		if clockTokens == nil {
+			clockTokens = fallbackSkeletonTokens(clock, ":")
+		}
+
+		glue := dateFieldValue(df.locale, "datetime."+skeletonDateStyle(date).String())
+		return parseDatePattern(strings.NewReplacer("{1}", datePatternString(dateTokens), "{0}", datePatternString(clockTokens)).Replace(glue))
+	}
+
+	if len(clock) > 0 {
+		return fallbackSkeletonTokens(clock, ":")
+	}
+	return fallbackSkeletonTokens(date, " ")
+}
+
+// matchSkeleton returns the available format of the locale for the skeleton fields, adjusting the width of the
+// fields to the requested ones, or nil if the locale has no format for these fields
+func (df *DateTimeFormatter) matchSkeleton(requested []dateToken) []dateToken {
+	if pattern := dateFieldValue(df.locale, "skeleton."+datePatternString(requested)); pattern != "" {
+		return parseDatePattern(pattern)
+	}
+
+	// try keeping the width of text months first, e.g. 'yMMMMd' before 'yMMMd'
+	var pattern string
+	for _, keepMonth := range []bool{true, false} {
+		canonical := make([]dateToken, len(requested))
+		for i, token := range requested {
+			canonical[i] = dateToken{field: token.field, count: 1}
+			if token.field == 'M' && token.count >= 3 {
+				canonical[i].count = 3
+				if keepMonth {
+					canonical[i].count = token.count
+				}
+			}
+		}
+
+		if pattern = dateFieldValue(df.locale, "skeleton."+datePatternString(canonical)); pattern != "" {
+			break
+		}
+	}
+	if pattern == "" {
+		return nil
+	}
+
+	tokens := parseDatePattern(pattern)
+	for i, token := range tokens {
+		for _, want := range requested {
+			if want.field != skeletonField(token.field) {
+				continue
+			}
+			switch {
+			case token.field == 'M' || token.field == 'L':
+				if (token.count >= 3) == (want.count >= 3) {
+					tokens[i].count = want.count
+				}
+			case token.field == 'E' || token.field == 'c':
+				if want.count > 3 || token.count < 3 {
+					tokens[i].count = max(want.count, 3)
+				}
+			default:
+				tokens[i].count = max(token.count, want.count)
+			}
+		}
+	}
+	return tokens
+}
+
+// normalizeSkeleton returns the fields of the skeleton in canonical order with 'j' replaced by the hour field, day
+// periods and the unsupported quarter and week of month fields removed and, if forced, the hours converted to the
+// hour field
+func normalizeSkeleton(tokens []dateToken, hour byte, force bool) []dateToken {
+	var fields []dateToken
+	for _, token := range tokens {
+		if token.field == 0 || strings.IndexByte("aBQqW", token.field) >= 0 {
+			continue
+		}
+		switch token.field {
+		case 'j':
+			token.field = hour
+		case 'h', 'H', 'k', 'K':
+			if force {
+				token.field = hour
+			}
+		case 'L':
+			token.field = 'M'
+		case 'c':
+			token.field = 'E'
+		}
+		fields = append(fields, token)
+	}
+
+	order := "GyMEdhHkKmsSzZOvVxX"
+	sort.SliceStable(fields, func(i, j int) bool {
+		return strings.IndexByte(order, fields[i].field) < strings.IndexByte(order, fields[j].field)
+	})
+	return fields
+}
+
+// skeletonField returns the skeleton letter matched by a pattern field
+func skeletonField(field byte) byte {
+	switch field {
+	case 'L':
+		return 'M'
+	case 'c':
+		return 'E'
+	case 'K':
+		return 'h'
+	case 'k':
+		return 'H'
+	}
+	return field
+}
+
+// skeletonDateStyle returns the date style used to combine a date and a time skeleton, following CLDR
+func skeletonDateStyle(date []dateToken) DateTimeStyle {
+	month, weekday := 0, 0
+	for _, token := range date {
+		switch token.field {
+		case 'M':
+			month = token.count
+		case 'E':
+			weekday = token.count
+		}
+	}
+
+	switch {
+	case month >= 4 && weekday >= 4:
+		return DateTimeFull
+	case month >= 4:
+		return DateTimeLong
+	case month == 3:
+		return DateTimeMedium
+	default:
+		return DateTimeShort
+	}
+}
+
+// fallbackSkeletonTokens joins the skeleton fields with the separator when the locale has no matching format, using
+// at least two digits for minutes and seconds
+func fallbackSkeletonTokens(fields []dateToken, separator string) []dateToken {
+	var tokens []dateToken
+	for i, field := range fields {
+		if i > 0 {
+			tokens = append(tokens, dateToken{literal: separator})
+		}
+		if field.field == 'm' || field.field == 's' {
+			field.count = max(field.count, 2)
+		}
+		tokens = append(tokens, field)
+	}
+	if len(fields) > 0 && fields[0].field == 'h' {
+		tokens = append(tokens, dateToken{literal: " "}, dateToken{field: 'a', count: 1})
+	}
+	return tokens
+}
+
+// formatField writes the localized value of a pattern field
+func (df *DateTimeFormatter) formatField(sb *strings.Builder, t time.Time, token dateToken) {
+	switch token.field {
+	case 'G':
+		eras := dateField(df.locale, "eras")
+		if t.Year() > 0 {
+			sb.WriteString(eras[1])
+		} else {
+			sb.WriteString(eras[0])
+		}
+	case 'y', 'u':
+		year := t.Year()
+		if token.field == 'y' && year <= 0 {
+			year = 1 - year
+		}
+		if token.count == 2 {
+			df.writeNumber(sb, year%100, 2)
+		} else {
+			df.writeNumber(sb, year, token.count)
+		}
+	case 'Y':
+		year, _ := t.ISOWeek()
+		if token.count == 2 {
+			df.writeNumber(sb, year%100, 2)
+		} else {
+			df.writeNumber(sb, year, token.count)
+		}
+	case 'M', 'L':
+		df.writeName(sb, "months", token, int(t.Month())-1, int(t.Month()))
+	case 'd':
+		df.writeNumber(sb, t.Day(), token.count)
+	case 'D':
+		df.writeNumber(sb, t.YearDay(), token.count)
+	case 'E':
+		df.writeName(sb, "days", dateToken{field: 'E', count: max(token.count, 3)}, int(t.Weekday()), int(t.Weekday())+1)
+	case 'c':
+		df.writeName(sb, "days", token, int(t.Weekday()), int(t.Weekday())+1)
+	case 'w':
+		_, week := t.ISOWeek()
+		df.writeNumber(sb, week, token.count)
+	case 'a', 'B':
+		periods := dateField(df.locale, "periods")
+		sb.WriteString(periods[t.Hour()/12])
+	case 'h':
+		df.writeNumber(sb, (t.Hour()+11)%12+1, token.count)
+	case 'H':
+		df.writeNumber(sb, t.Hour(), token.count)
+	case 'K':
+		df.writeNumber(sb, t.Hour()%12, token.count)
+	case 'k':
+		df.writeNumber(sb, (t.Hour()+23)%24+1, token.count)
+	case 'm':
+		df.writeNumber(sb, t.Minute(), token.count)
+	case 's':
+		df.writeNumber(sb, t.Second(), token.count)
+	case 'S':
+		fraction := fmt.Sprintf("%09d", t.Nanosecond())
+		for len(fraction) < token.count {
+			fraction += "0"
+		}
+		sb.WriteString(df.numbers.transliterate(fraction[:token.count]))
+	default:
+		df.formatZone(sb, t, token)
+	}
+}
+
+// formatZone writes the time zone fields, using the abbreviation of the location for short specific names and the
+// localized GMT format otherwise
+func (df *DateTimeFormatter) formatZone(sb *strings.Builder, t time.Time, token dateToken) {
+	name, offset := t.Zone()
+	switch token.field {
+	case 'z':
+		if token.count < 4 && name != "" && isASCIILetter(name[0]) {
+			sb.WriteString(name)
+			return
+		}
+		df.writeGMT(sb, offset, token.count >= 4)
+	case 'Z':
+		switch {
+		case token.count == 4:
+			df.writeGMT(sb, offset, true)
+		case token.count == 5 && offset == 0:
+			sb.WriteString("Z")
+		default:
+			sb.WriteString(df.numbers.transliterate(isoOffset(offset, token.count == 5)))
+		}
+	case 'O':
+		df.writeGMT(sb, offset, token.count >= 4)
+	case 'v', 'V':
+		if token.field == 'V' && token.count == 2 {
+			sb.WriteString(t.Location().String())
+			return
+		}
+		df.writeGMT(sb, offset, token.count >= 4)
+	case 'x', 'X':
+		if token.field == 'X' && offset == 0 {
+			sb.WriteString("Z")
+			return
+		}
+		text := isoOffset(offset, token.count == 3 || token.count == 5)
+		if token.count == 1 && offset%3600 == 0 {
+			text = text[:3]
+		}
+		sb.WriteString(df.numbers.transliterate(text))
+	default:
+		sb.WriteString(strings.Repeat(string(token.field), token.count))
+	}
+}
+
+// writeGMT writes the localized GMT format of the offset, e.g. 'GMT+2' or 'GMT+02:00' in the long form
+func (df *DateTimeFormatter) writeGMT(sb *strings.Builder, offset int, long bool) {
+	sb.WriteString("GMT")
+	if offset == 0 {
+		return
+	}
+
+	text := isoOffset(offset, true)
+	if !long {
+		hours, minutes, _ := strings.Cut(text[1:], ":")
+		text = text[:1] + strings.TrimPrefix(hours, "0")
+		if minutes != "00" {
+			text += ":" + minutes
+		}
+	}
+	sb.WriteString(df.numbers.transliterate(text))
+}
+
+// isoOffset returns the ISO 8601 representation of the offset in seconds, e.g. '+0530' or '+05:30' with a colon
+func isoOffset(offset int, colon bool) string {
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	if colon {
+		return fmt.Sprintf("%s%02d:%02d", sign, offset/3600, offset%3600/60)
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset%3600/60)
+}
+
+// writeNumber writes the number padded with zeros to the minimum digits using the digits of the locale
+func (df *DateTimeFormatter) writeNumber(sb *strings.Builder, number int, minimum int) {
+	sb.WriteString(df.numbers.transliterate(fmt.Sprintf("%0*d", minimum, number)))
+}
+
+// writeName writes the localized month or weekday name, or its number for the numeric forms
+func (df *DateTimeFormatter) writeName(sb *strings.Builder, field string, token dateToken, index int, number int) {
+	if token.count < 3 {
+		df.writeNumber(sb, number, token.count)
+		return
+	}
+
+	width := "abbr"
+	switch token.count {
+	case 4:
+		width = "wide"
+	case 5:
+		width = "narrow"
+	}
+
+	var names []string
+	if token.field == 'L' || token.field == 'c' {
+		names = dateField(df.locale, field+".standalone."+width)
+	}
+	if names == nil {
+		names = dateField(df.locale, field+"."+width)
+	}
+	sb.WriteString(names[index])
+}
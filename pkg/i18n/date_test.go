@@ -0,0 +1,132 @@
+package i18n_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+var testTime = time.Date(2024, time.March, 5, 14, 7, 9, 0, time.UTC)
+
+func TestDateTimeFormatterStyles(t *testing.T) {
+	tests := []struct {
+		formatter *i18n.DateTimeFormatter
+		expected  string
+	}{
+		{i18n.NewDateTimeFormatter("en-US"), "Mar 5, 2024"},
+		{i18n.NewDateTimeFormatter("en-US").WithDateStyle(i18n.DateTimeFull), "Tuesday, March 5, 2024"},
+		{i18n.NewDateTimeFormatter("en-US").WithDateStyle(i18n.DateTimeShort), "3/5/24"},
+		{i18n.NewDateTimeFormatter("en-GB").WithDateStyle(i18n.DateTimeShort), "05/03/2024"},
+		{i18n.NewDateTimeFormatter("de-DE").WithDateStyle(i18n.DateTimeFull), "Dienstag, 5. März 2024"},
+		{i18n.NewDateTimeFormatter("fr").WithDateStyle(i18n.DateTimeLong), "5 mars 2024"},
+		{i18n.NewDateTimeFormatter("es").WithDateStyle(i18n.DateTimeLong), "5 de marzo de 2024"},
+		{i18n.NewDateTimeFormatter("ru").WithDateStyle(i18n.DateTimeLong), "5 марта 2024 г."},
+		{i18n.NewDateTimeFormatter("ja").WithDateStyle(i18n.DateTimeFull), "2024年3月5日火曜日"},
+		{i18n.NewDateTimeFormatter("en").WithDateStyle(i18n.DateTimeNone).WithTimeStyle(i18n.DateTimeShort), "2:07\u202fPM"},
+		{i18n.NewDateTimeFormatter("de").WithDateStyle(i18n.DateTimeNone).WithTimeStyle(i18n.DateTimeMedium), "14:07:09"},
+		{i18n.NewDateTimeFormatter("ko").WithDateStyle(i18n.DateTimeNone).WithTimeStyle(i18n.DateTimeShort), "오후 2:07"},
+		{i18n.NewDateTimeFormatter("en").WithDateStyle(i18n.DateTimeLong).WithTimeStyle(i18n.DateTimeShort), "March 5, 2024 at 2:07\u202fPM"},
+		{i18n.NewDateTimeFormatter("de").WithTimeStyle(i18n.DateTimeShort), "05.03.2024, 14:07"},
+		{i18n.NewDateTimeFormatter("unknown"), "2024 M03 5"},
+	}
+
+	for _, test := range tests {
+		if v := test.formatter.Format(testTime); v != test.expected {
+			t.Errorf("expected '%s' for pattern '%s' but got '%s'", test.expected, test.formatter.Pattern(), v)
+		}
+	}
+}
+
+func TestDateTimeFormatterSkeletons(t *testing.T) {
+	tests := []struct {
+		locale   string
+		skeleton string
+		expected string
+	}{
+		{"en", "yMMMd", "Mar 5, 2024"},
+		{"en-GB", "yMMMd", "5 Mar 2024"},
+		{"de", "yMMMd", "5. März 2024"},
+		{"en", "MMMMd", "March 5"},
+		{"es", "yMMMMd", "5 de marzo de 2024"},
+		{"en", "yMMMMEEEEd", "Tuesday, March 5, 2024"},
+		{"en", "yMMdd", "03/05/2024"},
+		{"en", "Hm", "14:07"},
+		{"en", "jm", "2:07\u202fPM"},
+		{"de", "jm", "14:07"},
+		{"ja", "hm", "午後2:07"},
+		{"en", "yMMMdjm", "Mar 5, 2024, 2:07\u202fPM"},
+		{"ru", "LLLL", "март"},
+		{"ru", "MMMMd", "5 марта"},
+		{"en", "Hmz", "14:07 UTC"},
+		{"en", "hmv", "2:07\u202fPM GMT"},
+		{"de", "Hmsz", "14:07:09 UTC"},
+		{"en", "Hs", "14:09"},
+		{"en", "GyMMMd", "Mar 5, 2024 AD"},
+		{"de", "GyMMMd", "5. März 2024 n. Chr."},
+		{"en", "GyMMMdjmz", "Mar 5, 2024, 2:07\u202fPM AD UTC"},
+		{"en", "yQQQ", "2024"},
+		{"en", "MMMdW", "Mar 5"},
+	}
+
+	for _, test := range tests {
+		if v := i18n.NewDateTimeFormatter(test.locale).WithSkeleton(test.skeleton).Format(testTime); v != test.expected {
+			t.Errorf("expected '%s' for skeleton '%s' in locale '%s' but got '%s'", test.expected, test.skeleton, test.locale, v)
+		}
+	}
+}
+
+func TestDateTimeFormatterOptions(t *testing.T) {
+	if v := i18n.NewDateTimeFormatter("en").WithSkeleton("jm").WithHourCycle(i18n.HourCycle24).Format(testTime); v != "14:07" {
+		t.Errorf("expected '14:07' but got '%s'", v)
+	}
+
+	if v := i18n.NewDateTimeFormatter("de").WithDateStyle(i18n.DateTimeNone).WithTimeStyle(i18n.DateTimeShort).WithHourCycle(i18n.HourCycle12).Format(testTime); v != "2:07 PM" {
+		t.Errorf("expected '2:07 PM' but got '%s'", v)
+	}
+
+	if v := i18n.NewDateTimeFormatter("en").WithDateStyle(i18n.DateTimeNone).WithTimeStyle(i18n.DateTimeMedium).WithHourCycle(i18n.HourCycle24).Format(testTime); v != "14:07:09" {
+		t.Errorf("expected '14:07:09' but got '%s'", v)
+	}
+
+	if v := i18n.NewDateTimeFormatter("fr").WithPattern("EEEE d MMMM 'à' HH'h'mm").Format(testTime); v != "mardi 5 mars à 14h07" {
+		t.Errorf("expected 'mardi 5 mars à 14h07' but got '%s'", v)
+	}
+
+	location := time.FixedZone("", 2*60*60)
+	if v := i18n.NewDateTimeFormatter("en").WithPattern("HH:mm z xxx").WithLocation(location).Format(testTime); v != "16:07 GMT+2 +02:00" {
+		t.Errorf("expected '16:07 GMT+2 +02:00' but got '%s'", v)
+	}
+
+	if v := i18n.NewDateTimeFormatter("ar").WithSkeleton("Hm").Format(testTime); v != "١٤:٠٧" {
+		t.Errorf("expected '١٤:٠٧' but got '%s'", v)
+	}
+}
+
+func TestReaderFormatDate(t *testing.T) {
+	catalog := i18n.NewCatalog()
+	catalog.AddKeyValue("fr", i18n.NewKeyPair("due", "Échéance le {due, date, long} à {due, time, short}"))
+	catalog.AddKeyValue("fr", i18n.NewKeyPair("day", "{due, date, ::MMMMEEEEd}"))
+
+	catalogReader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("fr")
+
+	if v := catalogReader.FormatDate(testTime, i18n.DateTimeFull); v != "mardi 5 mars 2024" {
+		t.Errorf("expected 'mardi 5 mars 2024' but got '%s'", v)
+	}
+	if v := catalogReader.FormatTime(testTime, i18n.DateTimeShort); v != "14:07" {
+		t.Errorf("expected '14:07' but got '%s'", v)
+	}
+	if v := catalogReader.FormatDateTime(testTime, i18n.DateTimeLong, i18n.DateTimeShort); v != "5 mars 2024 à 14:07" {
+		t.Errorf("expected '5 mars 2024 à 14:07' but got '%s'", v)
+	}
+	if v := catalogReader.FormatSkeleton(testTime, "yMMMd"); v != "5 mars 2024" {
+		t.Errorf("expected '5 mars 2024' but got '%s'", v)
+	}
+
+	if v, err := catalogReader.FormatMessage("due", i18n.Args{"due": testTime}); err != nil || v != "Échéance le 5 mars 2024 à 14:07" {
+		t.Errorf("expected 'Échéance le 5 mars 2024 à 14:07' but got '%s' (%v)", v, err)
+	}
+	if v, err := catalogReader.FormatMessage("day", i18n.Args{"due": testTime}); err != nil || v != "mardi 5 mars" {
+		t.Errorf("expected 'mardi 5 mars' but got '%s' (%v)", v, err)
+	}
+}
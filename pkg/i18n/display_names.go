@@ -0,0 +1,208 @@
+package i18n
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DisplayContext selects the capitalization of display names
+type DisplayContext int
+
+const (
+	// DisplayInSentence keeps the CLDR capitalization, e.g. 'français (Canada)'
+	DisplayInSentence DisplayContext = iota
+	// DisplayStandalone capitalizes the first letter for menus and lists, e.g. 'Français (Canada)'
+	DisplayStandalone
+)
+
+//go:embed data/display_names.txt
+var displayNamesData string
+
+var (
+	displayNamesOnce     sync.Once
+	displayNamesEntries  map[string]string    // '<display locale>/<language|region|script>/<code>' -> name
+	displayNamesPatterns map[string][2]string // display locale -> locale pattern and separator
+)
+
+func loadDisplayNames() {
+	displayNamesEntries = make(map[string]string)
+	displayNamesPatterns = make(map[string][2]string)
+
+	scanner := bufio.NewScanner(strings.NewReader(displayNamesData))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := _numberDataRegex.FindAllString(line, -1)
+		if len(fields) != 4 {
+			panic(fmt.Sprintf("i18n: invalid display name data line '%s'", line))
+		}
+
+		if fields[1] == "pattern" {
+			pattern, err := strconv.Unquote(fields[2])
+			if err != nil {
+				panic(fmt.Sprintf("i18n: invalid display name data field '%s': %v", fields[2], err))
+			}
+			separator, err := strconv.Unquote(fields[3])
+			if err != nil {
+				panic(fmt.Sprintf("i18n: invalid display name data field '%s': %v", fields[3], err))
+			}
+			displayNamesPatterns[fields[0]] = [2]string{pattern, separator}
+			continue
+		}
+
+		name, err := strconv.Unquote(fields[3])
+		if err != nil {
+			panic(fmt.Sprintf("i18n: invalid display name data field '%s': %v", fields[3], err))
+		}
+		displayNamesEntries[fields[0]+"/"+fields[1]+"/"+fields[2]] = name
+	}
+}
+
+// DisplayNames returns the names of languages, regions, scripts and locales in a display locale using the CLDR
+// display names, falling back to the codes for unknown names; full names are embedded for the display locales en, fr,
+// de, es, it, pt, nl, ru, ja and zh, while ar, he, hi, ko, pl, sr, sv and tr only name their own language and region
+type DisplayNames struct {
+	locale  string
+	context DisplayContext
+}
+
+// NewDisplayNames returns new DisplayNames in the specified display locale
+func NewDisplayNames(displayLocale string) *DisplayNames {
+	return &DisplayNames{locale: displayLocale}
+}
+
+// WithDisplayContext sets the capitalization of the names, DisplayInSentence by default
+func (dn *DisplayNames) WithDisplayContext(context DisplayContext) *DisplayNames {
+	if dn != nil {
+		dn.context = context
+	}
+	return dn
+}
+
+// Language returns the name of the language code, e.g. 'French' for 'fr' in english
+func (dn *DisplayNames) Language(code string) string {
+	return dn.capitalize(dn.name("language", strings.ToLower(code)))
+}
+
+// Region returns the name of the region code, e.g. 'Canada' for 'CA'
+func (dn *DisplayNames) Region(code string) string {
+	return dn.capitalize(dn.name("region", strings.ToUpper(code)))
+}
+
+// Script returns the name of the script code, e.g. 'Cyrillic' for 'Cyrl' in english
+func (dn *DisplayNames) Script(code string) string {
+	if code != "" {
+		code = strings.ToUpper(code[:1]) + strings.ToLower(code[1:])
+	}
+	return dn.capitalize(dn.name("script", code))
+}
+
+// Locale returns the name of the locale with its script and region, e.g. 'French (Canada)' for 'fr-CA' or 'Serbian
+// (Latin, Serbia)' for 'sr-Latn-RS' in english
+func (dn *DisplayNames) Locale(locale string) string {
+	tokens := strings.Split(normalizeLocale(locale), "_")
+	if tokens[0] == "" {
+		return locale
+	}
+
+	name := dn.name("language", tokens[0])
+
+	var qualifiers []string
+	for _, token := range tokens[1:] {
+		switch {
+		case len(token) == 4 && !unicode.IsDigit(rune(token[0])):
+			qualifiers = append(qualifiers, dn.name("script", token))
+		case len(token) == 2 || len(token) == 3 && unicode.IsDigit(rune(token[0])):
+			qualifiers = append(qualifiers, dn.name("region", token))
+		default:
+			qualifiers = append(qualifiers, token)
+		}
+	}
+
+	if len(qualifiers) > 0 {
+		pattern, separator := dn.patterns()
+		joined := qualifiers[0]
+		for _, qualifier := range qualifiers[1:] {
+			joined = strings.NewReplacer("{0}", joined, "{1}", qualifier).Replace(separator)
+		}
+		name = strings.NewReplacer("{0}", name, "{1}", joined).Replace(pattern)
+	}
+
+	return dn.capitalize(name)
+}
+
+// LocaleName holds the name of a locale in a display locale and in the locale itself
+type LocaleName struct {
+	Locale     string
+	Name       string
+	NativeName string
+}
+
+// LocaleNames returns the name of each locale in the display locale and in the locale itself, e.g. to list the
+// loaded locales of a Catalog in a menu
+func (dn *DisplayNames) LocaleNames(locales []string) []LocaleName {
+	names := make([]LocaleName, len(locales))
+	for i, locale := range locales {
+		names[i] = LocaleName{
+			Locale:     locale,
+			Name:       dn.Locale(locale),
+			NativeName: NewDisplayNames(locale).WithDisplayContext(dn.contextOrDefault()).Locale(locale),
+		}
+	}
+	return names
+}
+
+func (dn *DisplayNames) contextOrDefault() DisplayContext {
+	if dn == nil {
+		return DisplayInSentence
+	}
+	return dn.context
+}
+
+// name returns the name of the code in the display locale or its parents, or the code itself
+func (dn *DisplayNames) name(kind string, code string) string {
+	displayNamesOnce.Do(loadDisplayNames)
+
+	if dn == nil {
+		return code
+	}
+	for _, candidate := range localeCandidates(dn.locale) {
+		if name, exists := displayNamesEntries[candidate+"/"+kind+"/"+code]; exists {
+			return name
+		}
+	}
+	return code
+}
+
+// patterns returns the locale pattern and separator of the display locale
+func (dn *DisplayNames) patterns() (string, string) {
+	displayNamesOnce.Do(loadDisplayNames)
+
+	if dn != nil {
+		for _, candidate := range localeCandidates(dn.locale) {
+			if patterns, exists := displayNamesPatterns[candidate]; exists {
+				return patterns[0], patterns[1]
+			}
+		}
+	}
+	return "{0} ({1})", "{0}, {1}"
+}
+
+// capitalize upper cases the first letter of the name for the DisplayStandalone context
+func (dn *DisplayNames) capitalize(name string) string {
+	if dn == nil || dn.context != DisplayStandalone || name == "" {
+		return name
+	}
+
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToTitle(r)) + name[size:]
+}
@@ -0,0 +1,59 @@
+package i18n_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestDisplayNames(t *testing.T) {
+	tests := []struct {
+		actual   string
+		expected string
+	}{
+		{i18n.NewDisplayNames("en").Locale("fr-CA"), "French (Canada)"},
+		{i18n.NewDisplayNames("fr").Locale("fr-CA"), "français (Canada)"},
+		{i18n.NewDisplayNames("fr").WithDisplayContext(i18n.DisplayStandalone).Locale("fr-CA"), "Français (Canada)"},
+		{i18n.NewDisplayNames("en-GB").Locale("sr-Latn-RS"), "Serbian (Latin, Serbia)"},
+		{i18n.NewDisplayNames("sr-Latn").Locale("sr-Latn-RS"), "srpski (latinica, Srbija)"},
+		{i18n.NewDisplayNames("zh").Locale("zh-Hant-TW"), "中文（繁体，台湾）"},
+		{i18n.NewDisplayNames("de").Locale("en"), "Englisch"},
+		{i18n.NewDisplayNames("en").Locale("xx-YY"), "xx (YY)"},
+		{i18n.NewDisplayNames("en").Language("ja"), "Japanese"},
+		{i18n.NewDisplayNames("ja").Language("ja"), "日本語"},
+		{i18n.NewDisplayNames("es").Region("mx"), "México"},
+		{i18n.NewDisplayNames("en").Script("cyrl"), "Cyrillic"},
+		{i18n.NewDisplayNames("unknown").Region("US"), "US"},
+		{i18n.NewDisplayNames("pl").Locale("pl-PL"), "polski (Polska)"},
+		{i18n.NewDisplayNames("pl").Locale("fr-CA"), "fr (CA)"},
+	}
+
+	for _, test := range tests {
+		if test.actual != test.expected {
+			t.Errorf("expected '%s' but got '%s'", test.expected, test.actual)
+		}
+	}
+}
+
+func TestReaderLocaleNames(t *testing.T) {
+	catalog := i18n.NewCatalog()
+	catalog.AddKeyValue("fr-CA", i18n.NewKeyPair("key", "valeur"))
+	catalog.AddKeyValue("en", i18n.NewKeyPair("key", "value"))
+	catalog.AddKeyValue("de", i18n.NewKeyPair("key", "Wert"))
+
+	if v := catalog.LoadedLocales(); !reflect.DeepEqual(v, []string{"de", "en", "fr-CA"}) {
+		t.Errorf("expected loaded locales [de en fr-CA] but got %v", v)
+	}
+
+	expected := []i18n.LocaleName{
+		{Locale: "de", Name: "German", NativeName: "Deutsch"},
+		{Locale: "en", Name: "English", NativeName: "English"},
+		{Locale: "fr-CA", Name: "French (Canada)", NativeName: "Français (Canada)"},
+	}
+
+	catalogReader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("en")
+	if v := catalogReader.LocaleNames(); !reflect.DeepEqual(v, expected) {
+		t.Errorf("expected %v but got %v", expected, v)
+	}
+}
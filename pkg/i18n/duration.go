@@ -0,0 +1,119 @@
+package i18n
+
+import (
+	"time"
+)
+
+// durationUnits lists the units of a DurationFormatter from the largest to the smallest
+var durationUnits = []struct {
+	unit     TimeUnit
+	duration time.Duration
+}{
+	{TimeUnitWeek, 7 * 24 * time.Hour},
+	{TimeUnitDay, 24 * time.Hour},
+	{TimeUnitHour, time.Hour},
+	{TimeUnitMinute, time.Minute},
+	{TimeUnitSecond, time.Second},
+	{TimeUnitMillisecond, time.Millisecond},
+}
+
+// DurationFormatter formats a time.Duration as localized units, such as '2 hours, 5 minutes', using the CLDR unit
+// patterns and plural rules of a locale
+type DurationFormatter struct {
+	locale       string
+	numbers      *NumberFormatter
+	largest      int
+	smallest     int
+	maximumUnits int
+}
+
+// NewDurationFormatter returns a new long DurationFormatter for the specified locale using days to seconds
+func NewDurationFormatter(locale string) *DurationFormatter {
+	return &DurationFormatter{
+		locale:   locale,
+		numbers:  NewNumberFormatter(locale).WithWidth(WidthLong),
+		largest:  durationUnitIndex(TimeUnitDay),
+		smallest: durationUnitIndex(TimeUnitSecond),
+	}
+}
+
+// durationUnitIndex returns the index of the unit in durationUnits, or -1 for units that are not fixed durations
+func durationUnitIndex(unit TimeUnit) int {
+	for i, candidate := range durationUnits {
+		if candidate.unit == unit {
+			return i
+		}
+	}
+	return -1
+}
+
+// WithWidth sets the width of the unit names, e.g. '2 hours', '2 hr' or '2h' in english
+func (df *DurationFormatter) WithWidth(width Width) *DurationFormatter {
+	if df != nil {
+		df.numbers.WithWidth(width)
+	}
+	return df
+}
+
+// WithUnits sets the largest and smallest units to use, from TimeUnitWeek to TimeUnitMillisecond; other units are
+// ignored
+func (df *DurationFormatter) WithUnits(largest TimeUnit, smallest TimeUnit) *DurationFormatter {
+	if df == nil {
+		return df
+	}
+
+	l, s := durationUnitIndex(largest), durationUnitIndex(smallest)
+	if l >= 0 && s >= 0 && l <= s {
+		df.largest, df.smallest = l, s
+	}
+	return df
+}
+
+// WithMaximumUnits limits the number of units displayed, rounding the smallest displayed unit; 0 displays all units
+func (df *DurationFormatter) WithMaximumUnits(maximumUnits int) *DurationFormatter {
+	if df != nil {
+		df.maximumUnits = max(maximumUnits, 0)
+	}
+	return df
+}
+
+// Format returns the localized representation of the duration, omitting units with a zero value
+func (df *DurationFormatter) Format(d time.Duration) string {
+	if df == nil {
+		return d.String()
+	}
+
+	negative := d < 0
+	if negative {
+		d = -d
+	}
+
+	smallest := df.smallest
+	if df.maximumUnits > 0 {
+		first := df.largest
+		for first < smallest && d < durationUnits[first].duration {
+			first++
+		}
+		smallest = min(smallest, first+df.maximumUnits-1)
+	}
+	d = d.Round(durationUnits[smallest].duration)
+
+	var parts []string
+	for i := df.largest; i <= smallest; i++ {
+		value := d / durationUnits[i].duration
+		d -= value * durationUnits[i].duration
+		if value > 0 {
+			parts = append(parts, df.numbers.FormatUnit(int64(value), string(durationUnits[i].unit)))
+		}
+	}
+
+	if len(parts) == 0 {
+		return df.numbers.FormatUnit(0, string(durationUnits[smallest].unit))
+	}
+
+	result := NewListFormatter(df.locale).WithStyle(ListUnit).WithWidth(df.numbers.width).Format(parts)
+	if negative {
+		result = df.numbers.symbols.minus + result
+	}
+	return result
+}
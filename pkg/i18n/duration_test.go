@@ -0,0 +1,35 @@
+package i18n_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestDurationFormatter(t *testing.T) {
+	tests := []struct {
+		formatter *i18n.DurationFormatter
+		duration  time.Duration
+		expected  string
+	}{
+		{i18n.NewDurationFormatter("en"), 2*time.Hour + 5*time.Minute, "2 hours, 5 minutes"},
+		{i18n.NewDurationFormatter("en"), 26*time.Hour + time.Second, "1 day, 2 hours, 1 second"},
+		{i18n.NewDurationFormatter("en"), 0, "0 seconds"},
+		{i18n.NewDurationFormatter("en"), 1500 * time.Millisecond, "2 seconds"},
+		{i18n.NewDurationFormatter("en").WithWidth(i18n.WidthShort), 2*time.Hour + 5*time.Minute, "2 hr, 5 min"},
+		{i18n.NewDurationFormatter("en").WithWidth(i18n.WidthNarrow), 2*time.Hour + 5*time.Minute, "2h 5m"},
+		{i18n.NewDurationFormatter("en").WithMaximumUnits(2), 2*time.Hour + 5*time.Minute + 40*time.Second, "2 hours, 6 minutes"},
+		{i18n.NewDurationFormatter("en").WithUnits(i18n.TimeUnitHour, i18n.TimeUnitMillisecond), 50*time.Hour + 250*time.Millisecond, "50 hours, 250 milliseconds"},
+		{i18n.NewDurationFormatter("en"), -90 * time.Second, "-1 minute, 30 seconds"},
+		{i18n.NewDurationFormatter("de"), 2*time.Hour + time.Minute, "2 Stunden und 1 Minute"},
+		{i18n.NewDurationFormatter("fr").WithWidth(i18n.WidthShort), 3 * time.Hour, "3\u00a0h"},
+		{i18n.NewDurationFormatter("ru"), 5 * time.Hour, "5 часов"},
+	}
+
+	for _, test := range tests {
+		if v := test.formatter.Format(test.duration); v != test.expected {
+			t.Errorf("expected '%s' for %v but got '%s'", test.expected, test.duration, v)
+		}
+	}
+}
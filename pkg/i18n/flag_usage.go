@@ -0,0 +1,156 @@
+package i18n
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+)
+
+const (
+	FlagUsageKey   = "flag.usage"
+	FlagDefaultKey = "flag.default"
+
+	defaultFlagUsage   = "Usage of {0}:"
+	defaultFlagDefault = "(default {0})"
+)
+
+// localeEnvironments lists the POSIX environment variables selecting the locale of messages, by precedence
+var localeEnvironments = []string{"LC_ALL", "LC_MESSAGES", "LANG"}
+
+// LocaleFromEnvironment returns the locale of messages selected by the first set LC_ALL, LC_MESSAGES or LANG
+// environment variable, without its codeset and modifier, e.g. 'fr_CA' for 'fr_CA.UTF-8'; it returns an empty
+// string when none is set or for the 'C' and 'POSIX' locales
+func LocaleFromEnvironment() string {
+	for _, name := range localeEnvironments {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+
+		locale, _, _ := strings.Cut(value, "@")
+		locale, _, _ = strings.Cut(locale, ".")
+		if locale == "C" || locale == "POSIX" {
+			return ""
+		}
+		return locale
+	}
+	return ""
+}
+
+// WithEnvironmentLocale sets the locale of the catalog reader to the locale of LocaleFromEnvironment, when there is
+// one, mapped to the matching locale of the reader catalog or of its language, e.g. 'fr-FR' or 'fr' for 'fr_FR'; the
+// catalog must be set first
+func (cr *CatalogReader) WithEnvironmentLocale() *CatalogReader {
+	locale := LocaleFromEnvironment()
+	if cr == nil || locale == "" {
+		return cr
+	}
+	return cr.WithLocale(cr.catalog.matchLocale(locale))
+}
+
+// LocalizeFlags sets the Usage function of the flag set to FlagUsage
+func (cr *CatalogReader) LocalizeFlags(flags *flag.FlagSet) {
+	if flags != nil {
+		flags.Usage = cr.FlagUsage(flags)
+	}
+}
+
+// FlagUsage returns a Usage function for the flag set printing the 'flag.usage' header ('Usage of {0}:') for the
+// name of the flag set, followed by the PrintFlagDefaults output
+func (cr *CatalogReader) FlagUsage(flags *flag.FlagSet) func() {
+	return func() {
+		fmt.Fprintln(flags.Output(), cr.text(FlagUsageKey, defaultFlagUsage, flags.Name()))
+		cr.PrintFlagDefaults(flags)
+	}
+}
+
+// PrintFlagDefaults prints the flags of the flag set like flag.PrintDefaults, with the usage of flags that are catalog
+// keys replaced by their value and the 'flag.default' suffix ('(default {0})') of flags with a non-zero default
+func (cr *CatalogReader) PrintFlagDefaults(flags *flag.FlagSet) {
+	flags.VisitAll(func(f *flag.Flag) {
+		fmt.Fprintln(flags.Output(), cr.flagDefaults(f))
+	})
+}
+
+// flagDefaults returns the PrintFlagDefaults lines of the flag
+func (cr *CatalogReader) flagDefaults(f *flag.Flag) string {
+	localized := *f
+	localized.Usage = cr.FlagDescription(f)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "  -%s", f.Name)
+	name, usage := flag.UnquoteUsage(&localized)
+	if len(name) > 0 {
+		sb.WriteString(" " + name)
+	}
+
+	// single letter flags without argument fit on the first line
+	if sb.Len() <= 4 {
+		sb.WriteString("\t")
+	} else {
+		sb.WriteString("\n    \t")
+	}
+	sb.WriteString(strings.ReplaceAll(usage, "\n", "\n    \t"))
+
+	if !isZeroFlagValue(f) {
+		value := f.DefValue
+		if getter, ok := f.Value.(flag.Getter); ok {
+			if _, ok := getter.Get().(string); ok {
+				value = fmt.Sprintf("%q", f.DefValue)
+			}
+		}
+		sb.WriteString(" " + cr.text(FlagDefaultKey, defaultFlagDefault, value))
+	}
+	return sb.String()
+}
+
+// FlagDescription returns the usage of the flag, replaced by its catalog value when it is a catalog key, e.g.
+// 'flag.output' for a flag defined with flags.String("output", "", "flag.output")
+func (cr *CatalogReader) FlagDescription(f *flag.Flag) string {
+	if cr == nil {
+		return f.Usage
+	}
+
+	value, exists := cr.catalog.Lookup(cr.locale, f.Usage)
+	if !exists {
+		return f.Usage
+	}
+
+	description, _ := cr.formatValue(value, cr.formatArg)
+	return description
+}
+
+// text returns the value of the key formatted with the arguments, or the fallback formatted with the arguments when
+// the key is not in the catalog
+func (cr *CatalogReader) text(key string, fallback string, args ...any) string {
+	keyValue := KeyValue(NewKeyPair(key, fallback))
+	if cr != nil {
+		if value, exists := cr.catalog.Lookup(cr.locale, key); exists {
+			keyValue = value
+		}
+	}
+
+	text, _ := cr.formatValue(keyValue, cr.formatArg, args...)
+	return text
+}
+
+// isZeroFlagValue returns whether the default value of the flag is the zero value of its type, like the flag package
+func isZeroFlagValue(f *flag.Flag) (zero bool) {
+	typ := reflect.TypeOf(f.Value)
+	var value reflect.Value
+	if typ.Kind() == reflect.Pointer {
+		value = reflect.New(typ.Elem())
+	} else {
+		value = reflect.Zero(typ)
+	}
+
+	// the String method of the zero value of custom flag types may panic
+	defer func() {
+		if recover() != nil {
+			zero = false
+		}
+	}()
+	return f.DefValue == value.Interface().(flag.Value).String()
+}
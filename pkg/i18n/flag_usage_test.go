@@ -0,0 +1,110 @@
+package i18n_test
+
+import (
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func newTestFlagSet(output *strings.Builder) *flag.FlagSet {
+	flags := flag.NewFlagSet("report", flag.ContinueOnError)
+	flags.SetOutput(output)
+	flags.String("output", "report.pdf", "flag.output")
+	flags.Int("n", 0, "flag.count")
+	flags.Bool("v", false, "verbose output")
+	flags.Duration("timeout", 30*time.Second, "`duration` before giving up")
+	flags.String("format", "", "output {format}")
+	return flags
+}
+
+func TestLocaleFromEnvironment(t *testing.T) {
+	tests := []struct {
+		all      string
+		messages string
+		lang     string
+		expected string
+	}{
+		{"", "", "fr_CA.UTF-8", "fr_CA"},
+		{"", "de_DE@euro", "fr_CA.UTF-8", "de_DE"},
+		{"pt_BR.UTF-8@latin", "de_DE", "fr_CA", "pt_BR"},
+		{"C", "de_DE", "fr_CA", ""},
+		{"", "", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Setenv("LC_ALL", test.all)
+		t.Setenv("LC_MESSAGES", test.messages)
+		t.Setenv("LANG", test.lang)
+		if v := i18n.LocaleFromEnvironment(); v != test.expected {
+			t.Errorf("expected '%s' for %v but got '%s'", test.expected, test, v)
+		}
+	}
+}
+
+func TestCatalogReaderFlagUsage(t *testing.T) {
+	catalog := i18n.NewCatalog()
+	catalog.AddKeyValue("fr", i18n.NewKeyPair("flag.usage", "Utilisation de {0} :"))
+	catalog.AddKeyValue("fr", i18n.NewKeyPair("flag.default", "(par défaut {0})"))
+	catalog.AddKeyValue("fr", i18n.NewKeyPair("flag.output", "`fichier` de sortie"))
+	catalog.AddKeyValue("fr", i18n.NewKeyPair("flag.count", "nombre de lignes"))
+
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_MESSAGES", "fr_FR.UTF-8")
+	reader := i18n.NewCatalogReader().WithCatalog(catalog).WithEnvironmentLocale()
+
+	var sb strings.Builder
+	flags := newTestFlagSet(&sb)
+	reader.LocalizeFlags(flags)
+	flags.Usage()
+
+	expected := "Utilisation de report :\n" +
+		"  -format string\n    \toutput {format}\n" +
+		"  -n int\n    \tnombre de lignes\n" +
+		"  -output fichier\n    \tfichier de sortie (par défaut \"report.pdf\")\n" +
+		"  -timeout duration\n    \tduration before giving up (par défaut 30s)\n" +
+		"  -v\tverbose output\n"
+	if sb.String() != expected {
+		t.Errorf("expected '%s' but got '%s'", expected, sb.String())
+	}
+}
+
+func TestCatalogReaderWithEnvironmentLocale(t *testing.T) {
+	catalog := i18n.NewCatalog()
+	catalog.AddKeyValue("fr", i18n.NewKeyPair("greeting", "Bonjour"))
+	catalog.AddKeyValue("pt-BR", i18n.NewKeyPair("greeting", "Olá"))
+	catalog.AddKeyValue("de_AT", i18n.NewKeyPair("greeting", "Servus"))
+
+	tests := []struct {
+		lang     string
+		expected string
+	}{
+		{"fr_FR.UTF-8", "fr"},
+		{"pt_BR.UTF-8", "pt-BR"},
+		{"de_AT", "de_AT"},
+		{"de_DE.UTF-8", "de_DE"},
+		{"C", "en"},
+	}
+
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_MESSAGES", "")
+	for _, test := range tests {
+		t.Setenv("LANG", test.lang)
+		reader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("en").WithEnvironmentLocale()
+		if v := reader.Locale(); v != test.expected {
+			t.Errorf("expected '%s' for '%s' but got '%s'", test.expected, test.lang, v)
+		}
+	}
+}
+
+func TestCatalogReaderPrintFlagDefaults(t *testing.T) {
+	var expected, sb strings.Builder
+	newTestFlagSet(&expected).PrintDefaults()
+
+	i18n.NewCatalogReader().WithCatalog(i18n.NewCatalog()).PrintFlagDefaults(newTestFlagSet(&sb))
+	if sb.String() != expected.String() {
+		t.Errorf("expected '%s' but got '%s'", expected.String(), sb.String())
+	}
+}
@@ -27,14 +27,15 @@ func (kp KeyPair) Value() string {
 	return kp.value
 }
 
-// NewKeyPairFromString returns a KeyPair object based on the specified keypair string (using the 'key=value' format)
+// NewKeyPairFromString returns a KeyPair object based on the specified keypair string (using the 'key=value' format);
+// the key ends at the first '=', so values may contain '=', e.g. ICU MessageFormat '=0' selectors
 func NewKeyPairFromString(keypair string) (KeyPair, error) {
-	tokens := strings.Split(keypair, "=")
+	key, value, found := strings.Cut(keypair, "=")
 	switch {
-	case len(tokens) != 2:
-		return KeyPair{}, fmt.Errorf("failed to parse keypair from string; found %d token(s)", len(tokens))
+	case !found:
+		return KeyPair{}, fmt.Errorf("failed to parse keypair from string; found no '=' separator")
 	default:
-		return NewKeyPair(tokens[0], tokens[1]), nil
+		return NewKeyPair(key, value), nil
 	}
 }
 
@@ -1,6 +1,7 @@
 package i18n_test
 
 import (
+	"bufio"
 	"strings"
 	"testing"
 
@@ -78,3 +79,24 @@ func TestFilteredFromDirectory(t *testing.T) {
 		t.Errorf("found key when it should be filtered, got '%s'", kp.Value())
 	}
 }
+
+func TestFromScannerMessageFormat(t *testing.T) {
+	parser := i18n.NewKeyPairFSParser([]string{})
+	catalog := i18n.NewCatalog()
+
+	scanner := bufio.NewScanner(strings.NewReader("files={count, plural, =0 {no files} one {# file} other {# files}}\nequation=a=b"))
+	if err := parser.FromScanner(catalog.AddKeyValue, "en", scanner); err != nil {
+		t.Fatalf("failed to load message format values; %v", err)
+	}
+
+	reader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("en")
+	for count, expected := range map[int]string{0: "no files", 1: "1 file", 2: "2 files"} {
+		if v, err := reader.FormatMessage("files", i18n.Args{"count": count}); err != nil || v != expected {
+			t.Errorf("expected '%s' for %d but got '%s' (%v)", expected, count, v, err)
+		}
+	}
+
+	if v := catalog.Get("en", "equation").Value(); v != "a=b" {
+		t.Errorf("expected 'a=b' but got '%s'", v)
+	}
+}
@@ -0,0 +1,204 @@
+package i18n
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ListStyle selects how a ListFormatter joins items
+type ListStyle int
+
+const (
+	// ListConjunction joins items with 'and', e.g. 'a, b, and c'
+	ListConjunction ListStyle = iota
+	// ListDisjunction joins items with 'or', e.g. 'a, b, or c'
+	ListDisjunction
+	// ListUnit joins measurements, e.g. '2 hours, 5 minutes'
+	ListUnit
+)
+
+func (s ListStyle) String() string {
+	switch s {
+	case ListDisjunction:
+		return "or"
+	case ListUnit:
+		return "unit"
+	default:
+		return "and"
+	}
+}
+
+//go:embed data/lists.txt
+var listsData string
+
+// listPatterns holds the patterns joining the first two items, the middle items, the last two items, and exactly two
+// items
+type listPatterns struct {
+	start  string
+	middle string
+	end    string
+	two    string
+}
+
+var (
+	listsOnce        sync.Once
+	listPatternsData map[string]*listPatterns // '<locale>/<style>/<width>' -> patterns
+)
+
+func loadListPatterns() {
+	listPatternsData = make(map[string]*listPatterns)
+
+	scanner := bufio.NewScanner(strings.NewReader(listsData))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := _numberDataRegex.FindAllString(line, -1)
+		if len(fields) != 7 {
+			panic(fmt.Sprintf("i18n: invalid list data line '%s'", line))
+		}
+
+		patterns := make([]string, 4)
+		for i, field := range fields[3:] {
+			pattern, err := strconv.Unquote(field)
+			if err != nil {
+				panic(fmt.Sprintf("i18n: invalid list data field '%s': %v", field, err))
+			}
+			patterns[i] = pattern
+		}
+
+		listPatternsData[fields[0]+"/"+fields[1]+"/"+fields[2]] = &listPatterns{
+			start:  patterns[0],
+			middle: patterns[1],
+			end:    patterns[2],
+			two:    patterns[3],
+		}
+	}
+}
+
+// listPatternsFor returns the list patterns of the style, trying the widths of a locale before falling back to its
+// parents and finally to 'root'
+func listPatternsFor(locale string, style ListStyle, width Width) *listPatterns {
+	listsOnce.Do(loadListPatterns)
+
+	widths := []Width{width}
+	switch width {
+	case WidthNarrow:
+		widths = append(widths, WidthShort, WidthLong)
+	case WidthShort:
+		widths = append(widths, WidthLong)
+	}
+
+	for _, candidate := range append(localeCandidates(locale), "root") {
+		for _, w := range widths {
+			if patterns, exists := listPatternsData[candidate+"/"+style.String()+"/"+w.String()]; exists {
+				return patterns
+			}
+		}
+	}
+	return listPatternsData["root/and/long"]
+}
+
+// ListFormatter joins items using the CLDR list patterns of a locale, e.g. 'a, b, and c' in english, 'a, b et c' in
+// french or 'a、b、c' in japanese
+type ListFormatter struct {
+	locale string
+	style  ListStyle
+	width  Width
+}
+
+// NewListFormatter returns a new ListFormatter for the specified locale joining items with the long conjunction
+func NewListFormatter(locale string) *ListFormatter {
+	return &ListFormatter{
+		locale: locale,
+		width:  WidthLong,
+	}
+}
+
+// WithStyle sets the list style, ListConjunction by default
+func (lf *ListFormatter) WithStyle(style ListStyle) *ListFormatter {
+	if lf != nil {
+		lf.style = style
+	}
+	return lf
+}
+
+// WithWidth sets the width of the list patterns, WidthLong being the CLDR standard width, e.g. 'a, b, and c', 'a, b,
+// & c' or 'a, b, c' in english
+func (lf *ListFormatter) WithWidth(width Width) *ListFormatter {
+	if lf != nil {
+		lf.width = width
+	}
+	return lf
+}
+
+// Format returns the items joined with the list patterns of the locale
+func (lf *ListFormatter) Format(items []string) string {
+	if lf == nil {
+		return strings.Join(items, ", ")
+	}
+
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	}
+
+	patterns := listPatternsFor(lf.locale, lf.style, lf.width)
+	if len(items) == 2 {
+		return lf.join(patterns.two, items[0], items[1])
+	}
+
+	result := lf.join(patterns.end, items[len(items)-2], items[len(items)-1])
+	for i := len(items) - 3; i > 0; i-- {
+		result = lf.join(patterns.middle, items[i], result)
+	}
+	return lf.join(patterns.start, items[0], result)
+}
+
+// join places the items in the pattern, adjusting the spanish conjunctions 'y' and 'o' to the following word
+func (lf *ListFormatter) join(pattern string, first string, second string) string {
+	if localeLanguage(lf.locale) == "es" {
+		pattern = spanishConjunction(pattern, second)
+	}
+	return strings.NewReplacer("{0}", first, "{1}", second).Replace(pattern)
+}
+
+// spanishConjunction replaces 'y' by 'e' before words starting with the 'i' sound and 'o' by 'u' before words
+// starting with the 'o' sound, following CLDR
+func spanishConjunction(pattern string, next string) string {
+	word := strings.ToLower(next)
+	switch {
+	case strings.HasSuffix(pattern, " y {1}") && spanishStartsWithI(word):
+		return strings.TrimSuffix(pattern, "y {1}") + "e {1}"
+	case strings.HasSuffix(pattern, " o {1}") && spanishStartsWithO(word):
+		return strings.TrimSuffix(pattern, "o {1}") + "u {1}"
+	}
+	return pattern
+}
+
+// spanishStartsWithI reports whether the word starts with 'i' or 'hi' not followed by a vowel, e.g. 'invierno' but
+// not 'hielo'
+func spanishStartsWithI(word string) bool {
+	runes := []rune(strings.TrimPrefix(word, "h"))
+	if len(runes) == 0 || runes[0] != 'i' && runes[0] != 'í' {
+		return false
+	}
+	return len(runes) == 1 || !strings.ContainsRune("aeiouáéíóú", runes[1])
+}
+
+// spanishStartsWithO reports whether the word starts with 'o', 'ho' or '8', or is the number 11
+func spanishStartsWithO(word string) bool {
+	if strings.HasPrefix(word, "8") || word == "11" {
+		return true
+	}
+	runes := []rune(strings.TrimPrefix(word, "h"))
+	return len(runes) > 0 && (runes[0] == 'o' || runes[0] == 'ó')
+}
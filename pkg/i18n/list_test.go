@@ -0,0 +1,49 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestListFormatter(t *testing.T) {
+	tests := []struct {
+		formatter *i18n.ListFormatter
+		items     []string
+		expected  string
+	}{
+		{i18n.NewListFormatter("en"), nil, ""},
+		{i18n.NewListFormatter("en"), []string{"a"}, "a"},
+		{i18n.NewListFormatter("en"), []string{"a", "b"}, "a and b"},
+		{i18n.NewListFormatter("en"), []string{"a", "b", "c"}, "a, b, and c"},
+		{i18n.NewListFormatter("en"), []string{"a", "b", "c", "d"}, "a, b, c, and d"},
+		{i18n.NewListFormatter("en-GB"), []string{"a", "b", "c"}, "a, b and c"},
+		{i18n.NewListFormatter("en").WithWidth(i18n.WidthShort), []string{"a", "b", "c"}, "a, b, & c"},
+		{i18n.NewListFormatter("en").WithWidth(i18n.WidthNarrow), []string{"a", "b", "c"}, "a, b, c"},
+		{i18n.NewListFormatter("en").WithStyle(i18n.ListDisjunction), []string{"a", "b", "c"}, "a, b, or c"},
+		{i18n.NewListFormatter("en").WithStyle(i18n.ListUnit).WithWidth(i18n.WidthNarrow), []string{"2h", "5m"}, "2h 5m"},
+		{i18n.NewListFormatter("fr"), []string{"a", "b", "c"}, "a, b et c"},
+		{i18n.NewListFormatter("fr-CA").WithStyle(i18n.ListDisjunction), []string{"a", "b"}, "a ou b"},
+		{i18n.NewListFormatter("de"), []string{"a", "b", "c"}, "a, b und c"},
+		{i18n.NewListFormatter("ja"), []string{"a", "b", "c"}, "a、b、c"},
+		{i18n.NewListFormatter("zh"), []string{"a", "b", "c"}, "a、b和c"},
+		{i18n.NewListFormatter("es"), []string{"agua", "hielo"}, "agua y hielo"},
+		{i18n.NewListFormatter("es"), []string{"padre", "hijo"}, "padre e hijo"},
+		{i18n.NewListFormatter("es").WithStyle(i18n.ListDisjunction), []string{"siete", "ocho"}, "siete u ocho"},
+		{i18n.NewListFormatter("unknown"), []string{"a", "b", "c"}, "a, b, c"},
+	}
+
+	for _, test := range tests {
+		if v := test.formatter.Format(test.items); v != test.expected {
+			t.Errorf("expected '%s' for %v but got '%s'", test.expected, test.items, v)
+		}
+	}
+}
+
+func TestReaderFormatList(t *testing.T) {
+	catalogReader := i18n.NewCatalogReader().WithCatalog(i18n.NewCatalog()).WithLocale("it")
+
+	if v := catalogReader.FormatList([]string{"rosso", "verde", "blu"}); v != "rosso, verde e blu" {
+		t.Errorf("expected 'rosso, verde e blu' but got '%s'", v)
+	}
+}
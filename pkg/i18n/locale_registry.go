@@ -0,0 +1,277 @@
+package i18n
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Direction is the direction of text, such as a locale writing direction
+type Direction int
+
+const (
+	// DirectionAuto leaves the direction unspecified, e.g. to derive it from the script of a locale
+	DirectionAuto Direction = iota
+	// DirectionLTR is the left-to-right direction of scripts such as Latin or Cyrillic
+	DirectionLTR
+	// DirectionRTL is the right-to-left direction of scripts such as Arabic or Hebrew
+	DirectionRTL
+)
+
+func (d Direction) String() string {
+	switch d {
+	case DirectionLTR:
+		return "ltr"
+	case DirectionRTL:
+		return "rtl"
+	default:
+		return "auto"
+	}
+}
+
+// rtlScripts lists the right-to-left scripts of the CLDR likely subtags
+var rtlScripts = map[string]bool{
+	"Adlm": true, "Arab": true, "Hebr": true, "Mand": true, "Nkoo": true, "Rohg": true, "Syrc": true, "Thaa": true,
+}
+
+// rtlLanguages lists the languages written in a right-to-left script by default
+var rtlLanguages = map[string]bool{
+	"ar": true, "ckb": true, "dv": true, "fa": true, "he": true, "iw": true, "ks": true, "ps": true, "sd": true,
+	"syr": true, "ug": true, "ur": true, "yi": true,
+}
+
+// LocaleDirection returns the writing direction of the locale script, or of the default script of its language,
+// e.g. DirectionRTL for 'ar-EG' and DirectionLTR for 'az-Latn'
+func LocaleDirection(locale string) Direction {
+	tokens := strings.Split(normalizeLocale(locale), "_")
+	for _, token := range tokens[1:] {
+		if len(token) == 4 && !unicode.IsDigit(rune(token[0])) {
+			if rtlScripts[token] {
+				return DirectionRTL
+			}
+			return DirectionLTR
+		}
+	}
+
+	if rtlLanguages[tokens[0]] {
+		return DirectionRTL
+	}
+	return DirectionLTR
+}
+
+// LocaleInfo holds the metadata of a locale
+type LocaleInfo struct {
+	// Locale is the locale as used by the catalog, e.g. 'pt-BR'
+	Locale string
+	// Direction is the writing direction, derived from the locale script when DirectionAuto
+	Direction Direction
+	// NativeName is the name of the locale in itself, derived from the CLDR display names when empty
+	NativeName string
+	// PluralRules is the locale whose CLDR plural rules apply, the locale itself when empty
+	PluralRules string
+	// Parent is the locale searched when a key is missing, the truncated locale ('pt' for 'pt-BR') when empty
+	Parent string
+	// Disabled excludes the locale from lookups, which continue with its parent, and from the loaded locales
+	Disabled bool
+}
+
+// LocaleRegistry records the metadata of locales, e.g. to set the fallback parents of the locales of a Catalog
+type LocaleRegistry struct {
+	locales map[string]LocaleInfo
+
+	lock sync.RWMutex
+}
+
+// NewLocaleRegistry returns a new empty LocaleRegistry
+func NewLocaleRegistry() *LocaleRegistry {
+	return &LocaleRegistry{
+		locales: make(map[string]LocaleInfo),
+	}
+}
+
+// Register adds the metadata of the locales to the registry, replacing the metadata previously registered for them
+func (r *LocaleRegistry) Register(infos ...LocaleInfo) *LocaleRegistry {
+	if r != nil {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+
+		for _, info := range infos {
+			r.locales[normalizeLocale(info.Locale)] = info
+		}
+	}
+	return r
+}
+
+var errNoRegistry = errors.New("locale registry is nil")
+
+var _localeMetadataRegex = regexp.MustCompile(`[^\s=]+="(?:[^"\\]|\\.)*"|\S+`)
+
+// FromReader registers the locales described by the metadata lines read from the reader; each line holds a locale
+// followed by optional 'direction', 'name', 'plural', 'parent' and 'enabled' attributes, e.g.
+// 'ar direction=rtl name="العربية"' or 'pt-AO parent=pt-PT enabled=false'
+func (r *LocaleRegistry) FromReader(reader io.Reader) error {
+	if r == nil {
+		return errNoRegistry
+	}
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		fields := _localeMetadataRegex.FindAllString(line, -1)
+		info := LocaleInfo{Locale: fields[0]}
+		for _, field := range fields[1:] {
+			if err := info.setAttribute(field); err != nil {
+				return fmt.Errorf("invalid metadata for locale '%s': %w", info.Locale, err)
+			}
+		}
+		r.Register(info)
+	}
+
+	return scanner.Err()
+}
+
+// FromFile registers the locales described by the metadata file located at the specified path
+func (r *LocaleRegistry) FromFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open '%s': %w", path, err)
+	}
+	defer f.Close()
+
+	if err := r.FromReader(f); err != nil {
+		return fmt.Errorf("failed to load locale metadata from '%s': %w", path, err)
+	}
+	return nil
+}
+
+// setAttribute sets the metadata field of a 'name=value' attribute, the value being optionally quoted
+func (info *LocaleInfo) setAttribute(attribute string) error {
+	name, value, found := strings.Cut(attribute, "=")
+	if !found {
+		return fmt.Errorf("expected 'name=value' attribute; found '%s'", attribute)
+	}
+
+	if strings.HasPrefix(value, `"`) {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for attribute '%s': %w", name, err)
+		}
+		value = unquoted
+	}
+
+	switch name {
+	case "direction":
+		switch value {
+		case "ltr":
+			info.Direction = DirectionLTR
+		case "rtl":
+			info.Direction = DirectionRTL
+		case "auto":
+			info.Direction = DirectionAuto
+		default:
+			return fmt.Errorf("unknown direction '%s'", value)
+		}
+	case "name":
+		info.NativeName = value
+	case "plural":
+		info.PluralRules = value
+	case "parent":
+		info.Parent = value
+	case "enabled":
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for attribute '%s': %w", name, err)
+		}
+		info.Disabled = !enabled
+	default:
+		return fmt.Errorf("unknown attribute '%s'", name)
+	}
+	return nil
+}
+
+// Lookup returns the metadata registered for the locale and whether it was found
+func (r *LocaleRegistry) Lookup(locale string) (LocaleInfo, bool) {
+	if r == nil {
+		return LocaleInfo{}, false
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	info, exists := r.locales[normalizeLocale(locale)]
+	return info, exists
+}
+
+// Info returns the metadata of the locale, deriving the direction, native name and plural rules of unregistered
+// locales and unset fields from the CLDR data
+func (r *LocaleRegistry) Info(locale string) LocaleInfo {
+	info, exists := r.Lookup(locale)
+	if !exists {
+		info = LocaleInfo{Locale: locale}
+	}
+
+	if info.Direction == DirectionAuto {
+		info.Direction = LocaleDirection(locale)
+	}
+	if info.NativeName == "" {
+		info.NativeName = NewDisplayNames(locale).Locale(locale)
+	}
+	if info.PluralRules == "" {
+		info.PluralRules = locale
+	}
+	return info
+}
+
+// Parent returns the locale searched after the specified locale, either its registered parent or the locale truncated
+// of its last subtag; a nil registry has no parents
+func (r *LocaleRegistry) Parent(locale string) string {
+	if r == nil {
+		return ""
+	}
+
+	if info, exists := r.Lookup(locale); exists && info.Parent != "" {
+		return info.Parent
+	}
+
+	index := strings.LastIndexAny(locale, "-_")
+	if index < 0 {
+		return ""
+	}
+	return locale[:index]
+}
+
+// Enabled returns whether the locale is enabled, which is the case of unregistered locales
+func (r *LocaleRegistry) Enabled(locale string) bool {
+	info, exists := r.Lookup(locale)
+	return !exists || !info.Disabled
+}
+
+// Locales returns the sorted locales of the registry
+func (r *LocaleRegistry) Locales() []string {
+	if r == nil {
+		return []string{}
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	locales := make([]string, 0, len(r.locales))
+	for _, info := range r.locales {
+		locales = append(locales, info.Locale)
+	}
+	sort.Strings(locales)
+
+	return locales
+}
@@ -0,0 +1,144 @@
+package i18n_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestLocaleDirection(t *testing.T) {
+	tests := []struct {
+		locale   string
+		expected i18n.Direction
+	}{
+		{"en-US", i18n.DirectionLTR},
+		{"ar", i18n.DirectionRTL},
+		{"he-IL", i18n.DirectionRTL},
+		{"fa_IR", i18n.DirectionRTL},
+		{"az-Arab", i18n.DirectionRTL},
+		{"ug-Cyrl", i18n.DirectionLTR},
+		{"unknown", i18n.DirectionLTR},
+	}
+
+	for _, test := range tests {
+		if v := i18n.LocaleDirection(test.locale); v != test.expected {
+			t.Errorf("expected '%s' for locale '%s' but got '%s'", test.expected, test.locale, v)
+		}
+	}
+}
+
+func TestLocaleRegistryFromReader(t *testing.T) {
+	metadata := `# locale metadata
+ar direction=rtl name="العربية"
+pt-AO parent=pt-PT plural=pt_PT
+es-419 enabled=false
+`
+
+	registry := i18n.NewLocaleRegistry()
+	if err := registry.FromReader(strings.NewReader(metadata)); err != nil {
+		t.Fatalf("expected no error but got %v", err)
+	}
+
+	if v := registry.Locales(); !reflect.DeepEqual(v, []string{"ar", "es-419", "pt-AO"}) {
+		t.Errorf("expected locales [ar es-419 pt-AO] but got %v", v)
+	}
+
+	expected := i18n.LocaleInfo{Locale: "ar", Direction: i18n.DirectionRTL, NativeName: "العربية", PluralRules: "ar"}
+	if v := registry.Info("ar"); v != expected {
+		t.Errorf("expected %v but got %v", expected, v)
+	}
+
+	expected = i18n.LocaleInfo{Locale: "de-CH", Direction: i18n.DirectionLTR, NativeName: "Deutsch (Schweiz)", PluralRules: "de-CH"}
+	if v := registry.Info("de-CH"); v != expected {
+		t.Errorf("expected %v but got %v", expected, v)
+	}
+
+	if v := registry.Parent("pt_ao"); v != "pt-PT" {
+		t.Errorf("expected parent 'pt-PT' but got '%s'", v)
+	}
+	if v := registry.Parent("pt-PT"); v != "pt" {
+		t.Errorf("expected parent 'pt' but got '%s'", v)
+	}
+	if registry.Enabled("es-419") || !registry.Enabled("es") {
+		t.Errorf("expected 'es-419' to be disabled and 'es' to be enabled")
+	}
+
+	for _, invalid := range []string{"ar direction=up", "ar size=1", "ar enabled", `ar name="unterminated`} {
+		if err := i18n.NewLocaleRegistry().FromReader(strings.NewReader(invalid)); err == nil {
+			t.Errorf("expected an error for metadata '%s'", invalid)
+		}
+	}
+}
+
+func TestCatalogRegistryFallback(t *testing.T) {
+	registry := i18n.NewLocaleRegistry().Register(
+		i18n.LocaleInfo{Locale: "pt-AO", Parent: "pt-PT", PluralRules: "pt_PT"},
+		i18n.LocaleInfo{Locale: "fr-CA", Disabled: true},
+	)
+
+	catalog := i18n.NewCatalog().WithRegistry(registry).WithDefaultLocale("en")
+	catalog.AddKeyValue("en", i18n.NewKeyPair("color", "color"))
+	catalog.AddKeyValue("pt", i18n.NewKeyPair("color", "cor"))
+	catalog.AddKeyValue("pt-PT", i18n.NewKeyPair("files[one]", "{0} ficheiro"))
+	catalog.AddKeyValue("pt-PT", i18n.NewKeyPair("files[other]", "{0} ficheiros"))
+	catalog.AddKeyValue("fr", i18n.NewKeyPair("color", "couleur"))
+	catalog.AddKeyValue("fr-CA", i18n.NewKeyPair("color", "couleur (CA)"))
+
+	tests := []struct {
+		locale   string
+		key      string
+		expected string
+	}{
+		{"pt-AO", "color", "cor"},
+		{"pt-BR", "color", "cor"},
+		{"fr-CA", "color", "couleur"},
+		{"de-AT", "color", "color"},
+	}
+
+	for _, test := range tests {
+		if v := catalog.Get(test.locale, test.key).Value(); v != test.expected {
+			t.Errorf("expected '%s' for key '%s' in locale '%s' but got '%s'", test.expected, test.key, test.locale, v)
+		}
+	}
+
+	catalogReader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("pt-AO")
+	if v := catalogReader.Plural("files", 0).Value(); v != "{0} ficheiros" {
+		t.Errorf("expected '{0} ficheiros' but got '%s'", v)
+	}
+
+	if v := catalog.LoadedLocales(); !reflect.DeepEqual(v, []string{"en", "fr", "pt", "pt-PT"}) {
+		t.Errorf("expected loaded locales [en fr pt pt-PT] but got %v", v)
+	}
+}
+
+func TestReaderDirection(t *testing.T) {
+	registry := i18n.NewLocaleRegistry().Register(i18n.LocaleInfo{Locale: "x-private", Direction: i18n.DirectionRTL, NativeName: "Private"})
+	catalog := i18n.NewCatalog().WithRegistry(registry)
+	catalog.AddKeyValue("x-private", i18n.NewKeyPair("key", "value"))
+
+	tests := []struct {
+		locale   string
+		expected i18n.Direction
+	}{
+		{"en", i18n.DirectionLTR},
+		{"ar-EG", i18n.DirectionRTL},
+		{"x-private", i18n.DirectionRTL},
+	}
+
+	for _, test := range tests {
+		if v := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale(test.locale).Direction(); v != test.expected {
+			t.Errorf("expected '%s' for locale '%s' but got '%s'", test.expected, test.locale, v)
+		}
+	}
+
+	if v := i18n.NewCatalogReader().WithLocale("he").Direction(); v != i18n.DirectionRTL {
+		t.Errorf("expected 'rtl' without a catalog but got '%s'", v)
+	}
+
+	names := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("en").LocaleNames()
+	if len(names) != 1 || names[0].NativeName != "Private" {
+		t.Errorf("expected the registered native name 'Private' but got %v", names)
+	}
+}
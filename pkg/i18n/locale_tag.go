@@ -0,0 +1,58 @@
+package i18n
+
+import "strings"
+
+// normalizeLocale converts a locale identifier such as 'pt-br' or 'sr_latn_rs' into the canonical 'pt_BR' or
+// 'sr_Latn_RS' form used by the embedded locale data
+func normalizeLocale(locale string) string {
+	tokens := strings.FieldsFunc(locale, func(r rune) bool { return r == '-' || r == '_' })
+	for i, token := range tokens {
+		switch {
+		case i == 0:
+			tokens[i] = strings.ToLower(token)
+		case len(token) == 4:
+			tokens[i] = strings.ToUpper(token[:1]) + strings.ToLower(token[1:])
+		default:
+			tokens[i] = strings.ToUpper(token)
+		}
+	}
+	return strings.Join(tokens, "_")
+}
+
+// localeCandidates returns the normalized locale followed by each of its truncated parents, e.g. 'sr_Latn_RS',
+// 'sr_Latn' and 'sr'
+func localeCandidates(locale string) []string {
+	normalized := normalizeLocale(locale)
+
+	candidates := make([]string, 0)
+	for normalized != "" {
+		candidates = append(candidates, normalized)
+
+		index := strings.LastIndexByte(normalized, '_')
+		if index < 0 {
+			break
+		}
+		normalized = normalized[:index]
+	}
+	return candidates
+}
+
+// localeLanguage returns the normalized language of the locale, e.g. 'sr' for 'sr-Latn-RS'
+func localeLanguage(locale string) string {
+	language, _, _ := strings.Cut(normalizeLocale(locale), "_")
+	return language
+}
+
+// localeTag returns the BCP 47 language tag of the locale, e.g. 'pt-BR' for 'pt_br'
+func localeTag(locale string) string {
+	return strings.ReplaceAll(normalizeLocale(locale), "_", "-")
+}
+
+// knownLanguage returns whether the language of the locale is a language of the CLDR plural rules, which list every
+// language with CLDR data
+func knownLanguage(locale string) bool {
+	pluralRulesOnce.Do(loadPluralRules)
+
+	_, exists := pluralRulesData["cardinal"][localeLanguage(locale)]
+	return exists
+}
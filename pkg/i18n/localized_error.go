@@ -0,0 +1,117 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+)
+
+// LocalizedError is an error carrying a catalog key and the arguments of its message, rendered in the locale of the
+// caller at the edge of the application, e.g. in an HTTP response or a command output, with Localize or
+// LocalizeContext; Error returns the message in the default locale of its catalog, e.g. for logs
+type LocalizedError struct {
+	// Key is the catalog key of the error message
+	Key string
+	// Args are the arguments of the error message, like the arguments of CatalogReader.Format
+	Args []any
+	// Err is the wrapped error, if any
+	Err error
+
+	catalog *Catalog
+}
+
+// NewLocalizedError returns a new LocalizedError for the catalog key and the arguments of its message
+func NewLocalizedError(key string, args ...any) *LocalizedError {
+	return &LocalizedError{Key: key, Args: args}
+}
+
+// WrapLocalizedError returns a new LocalizedError for the catalog key and arguments wrapping the specified error
+func WrapLocalizedError(err error, key string, args ...any) *LocalizedError {
+	return &LocalizedError{Key: key, Args: args, Err: err}
+}
+
+// WithCatalog sets the catalog whose default locale renders the Error message
+func (le *LocalizedError) WithCatalog(catalog *Catalog) *LocalizedError {
+	if le != nil {
+		le.catalog = catalog
+	}
+	return le
+}
+
+// Error returns the message of the error in the default locale of its catalog, or its key without catalog, followed
+// by the message of the wrapped error
+func (le *LocalizedError) Error() string {
+	if le == nil {
+		return ""
+	}
+
+	if le.catalog == nil {
+		return le.withWrapped(le.Key)
+	}
+	return le.withWrapped(le.Localize(defaultCatalogReader(le.catalog)))
+}
+
+// withWrapped returns the message followed by the message of the wrapped error, if any
+func (le *LocalizedError) withWrapped(message string) string {
+	if le.Err != nil {
+		return message + ": " + le.Err.Error()
+	}
+	return message
+}
+
+// Unwrap returns the wrapped error
+func (le *LocalizedError) Unwrap() error {
+	if le == nil {
+		return nil
+	}
+	return le.Err
+}
+
+// Is returns whether the target is a LocalizedError with the same key, e.g. a sentinel LocalizedError
+func (le *LocalizedError) Is(target error) bool {
+	other, ok := target.(*LocalizedError)
+	return ok && le != nil && other != nil && other.Key == le.Key
+}
+
+// Localize returns the message of the error in the locale of the catalog reader; the wrapped error is not part of
+// the message, which is meant for the users of the application
+func (le *LocalizedError) Localize(reader *CatalogReader) string {
+	if le == nil {
+		return ""
+	}
+
+	message, _ := reader.Format(le.Key, le.Args...)
+	return message
+}
+
+// LocalizeContext returns the message of the error in the locale of the catalog reader of the context, or the Error
+// message when the context has no catalog reader
+func (le *LocalizedError) LocalizeContext(ctx context.Context) string {
+	reader := CatalogReaderFromContext(ctx)
+	if reader == nil {
+		return le.Error()
+	}
+	return le.Localize(reader)
+}
+
+// defaultCatalogReader returns a catalog reader of the default locale of the catalog, ignoring the reader locale
+// environment
+func defaultCatalogReader(catalog *Catalog) *CatalogReader {
+	if catalog == nil {
+		return &CatalogReader{}
+	}
+	return &CatalogReader{catalog: catalog, locale: catalog.defaultLocale}
+}
+
+// LocalizeError returns the localized message of the first LocalizedError of the error chain for the catalog reader
+// of the context, or the message of the error when the chain has no LocalizedError
+func LocalizeError(ctx context.Context, err error) string {
+	var le *LocalizedError
+	if errors.As(err, &le) {
+		return le.LocalizeContext(ctx)
+	}
+
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,76 @@
+package i18n_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+var errTestNotFound = i18n.NewLocalizedError("error.not_found")
+
+func newLocalizedErrorTestCatalog() *i18n.Catalog {
+	catalog := i18n.NewCatalog().WithDefaultLocale("en")
+	catalog.AddKeyValue("en", i18n.NewKeyPair("error.not_found", "{0} was not found"))
+	catalog.AddKeyValue("fr", i18n.NewKeyPair("error.not_found", "{0} est introuvable"))
+	catalog.AddKeyValue("fr", i18n.NewKeyPair("error.quota", "Quota de {name} dépassé"))
+	return catalog
+}
+
+func TestLocalizedError(t *testing.T) {
+	catalog := newLocalizedErrorTestCatalog()
+	err := fmt.Errorf("failed to load report: %w",
+		i18n.WrapLocalizedError(fs.ErrNotExist, "error.not_found", "report.pdf").WithCatalog(catalog))
+
+	if expected := "failed to load report: report.pdf was not found: file does not exist"; err.Error() != expected {
+		t.Errorf("expected '%s' but got '%s'", expected, err.Error())
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Error("expected the error to wrap fs.ErrNotExist")
+	}
+	if !errors.Is(err, errTestNotFound) {
+		t.Error("expected the error to match the sentinel with the same key")
+	}
+	if errors.Is(err, i18n.NewLocalizedError("error.quota")) {
+		t.Error("expected the error not to match a different key")
+	}
+
+	var le *i18n.LocalizedError
+	if !errors.As(err, &le) || le.Key != "error.not_found" {
+		t.Fatalf("expected errors.As to find the localized error")
+	}
+
+	reader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("fr")
+	if v := le.Localize(reader); v != "report.pdf est introuvable" {
+		t.Errorf("expected 'report.pdf est introuvable' but got '%s'", v)
+	}
+
+	if v := i18n.NewLocalizedError("error.quota").Error(); v != "error.quota" {
+		t.Errorf("expected 'error.quota' but got '%s'", v)
+	}
+}
+
+func TestLocalizeError(t *testing.T) {
+	catalog := newLocalizedErrorTestCatalog()
+	_, ctx := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("fr").WithNewContext()
+
+	tests := []struct {
+		ctx      context.Context
+		err      error
+		expected string
+	}{
+		{ctx, fmt.Errorf("quota: %w", i18n.NewLocalizedError("error.quota", i18n.Args{"name": "Bob"})), "Quota de Bob dépassé"},
+		{ctx, errors.New("plain"), "plain"},
+		{ctx, nil, ""},
+		{context.Background(), i18n.NewLocalizedError("error.not_found", "x").WithCatalog(catalog), "x was not found"},
+	}
+
+	for _, test := range tests {
+		if v := i18n.LocalizeError(test.ctx, test.err); v != test.expected {
+			t.Errorf("expected '%s' but got '%s'", test.expected, v)
+		}
+	}
+}
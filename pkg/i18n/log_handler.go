@@ -0,0 +1,142 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+const (
+	LogKeyAttribute    = "i18n.key"
+	LogLocaleAttribute = "i18n.locale"
+)
+
+// LogHandler is a slog.Handler rendering catalog-keyed log messages in the locale of its catalog reader, e.g. a fixed
+// operator locale, before passing the records to the wrapped handler:
+//
+//   - a record message that is a key of the catalog is replaced by its value, formatted with the record attributes as
+//     named arguments, and the 'i18n.key' and 'i18n.locale' attributes are added to the record
+//   - LocalizedError attribute values are rendered in the reader locale, followed by their wrapped error, and add the
+//     'i18n.key' and 'i18n.locale' attributes of the first of them when the message is not a key
+//
+// The 'i18n.key' and 'i18n.locale' attributes stay at the top level of the records when the handler has groups.
+type LogHandler struct {
+	handler slog.Handler
+	reader  *CatalogReader
+	groups  []logGroup // groups of WithGroup, applied to the record attributes by Handle
+}
+
+// logGroup is a group of a LogHandler with the attributes added to it by WithAttrs
+type logGroup struct {
+	name  string
+	attrs []slog.Attr
+}
+
+// NewLogHandler returns a new LogHandler wrapping the handler and rendering messages with the catalog reader
+func NewLogHandler(handler slog.Handler, reader *CatalogReader) *LogHandler {
+	return &LogHandler{handler: handler, reader: reader}
+}
+
+// Enabled returns whether the wrapped handler handles records of the level
+func (lh *LogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return lh.handler.Enabled(ctx, level)
+}
+
+// Handle renders the catalog-keyed message and the localized errors of the record before passing it to the wrapped
+// handler
+func (lh *LogHandler) Handle(ctx context.Context, record slog.Record) error {
+	if lh.reader == nil {
+		var attrs []slog.Attr
+		record.Attrs(func(attr slog.Attr) bool {
+			attrs = append(attrs, attr)
+			return true
+		})
+		return lh.handle(ctx, record, record.Message, attrs, "")
+	}
+
+	var attrs []slog.Attr
+	args := Args{}
+	key := ""
+	record.Attrs(func(attr slog.Attr) bool {
+		attr.Value = attr.Value.Resolve()
+		if le := localizedErrorOf(attr.Value); le != nil {
+			attr.Value = slog.StringValue(le.withWrapped(le.Localize(lh.reader)))
+			if key == "" {
+				key = le.Key
+			}
+		}
+		if attr.Value.Kind() != slog.KindGroup {
+			args[attr.Key] = attr.Value.Any()
+		}
+		attrs = append(attrs, attr)
+		return true
+	})
+
+	message := record.Message
+	if _, exists := lh.reader.catalog.Lookup(lh.reader.Locale(), record.Message); exists {
+		message, _ = lh.reader.Format(record.Message, args)
+		key = record.Message
+	}
+
+	return lh.handle(ctx, record, message, attrs, key)
+}
+
+// handle passes a record of the message and attributes to the wrapped handler, nesting the attributes in the groups
+// of the handler and adding the 'i18n.key' and 'i18n.locale' attributes of the key at the top level
+func (lh *LogHandler) handle(ctx context.Context, record slog.Record, message string, attrs []slog.Attr, key string) error {
+	for i := len(lh.groups) - 1; i >= 0; i-- {
+		groupAttrs := append(append([]slog.Attr{}, lh.groups[i].attrs...), attrs...)
+		attrs = []slog.Attr{{Key: lh.groups[i].name, Value: slog.GroupValue(groupAttrs...)}}
+	}
+
+	localized := slog.NewRecord(record.Time, record.Level, message, record.PC)
+	localized.AddAttrs(attrs...)
+	if key != "" {
+		localized.AddAttrs(lh.reader.LogAttrs(key)...)
+	}
+	return lh.handler.Handle(ctx, localized)
+}
+
+// localizedErrorOf returns the first LocalizedError of the error chain of the value, or nil
+func localizedErrorOf(value slog.Value) *LocalizedError {
+	err, ok := value.Any().(error)
+	if value.Kind() != slog.KindAny || !ok {
+		return nil
+	}
+
+	var le *LocalizedError
+	if errors.As(err, &le) {
+		return le
+	}
+	return nil
+}
+
+// WithAttrs returns a LogHandler wrapping the wrapped handler with the attributes, or adding them to its last group;
+// these attributes are not arguments of the catalog-keyed messages
+func (lh *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(lh.groups) == 0 {
+		return NewLogHandler(lh.handler.WithAttrs(attrs), lh.reader)
+	}
+
+	groups := append([]logGroup{}, lh.groups...)
+	last := &groups[len(groups)-1]
+	last.attrs = append(append([]slog.Attr{}, last.attrs...), attrs...)
+	return &LogHandler{handler: lh.handler, reader: lh.reader, groups: groups}
+}
+
+// WithGroup returns a LogHandler nesting the attributes of the records and of WithAttrs in the group; the group is
+// applied by Handle so that the 'i18n.key' and 'i18n.locale' attributes stay at the top level
+func (lh *LogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return lh
+	}
+
+	groups := append(append([]logGroup{}, lh.groups...), logGroup{name: name})
+	return &LogHandler{handler: lh.handler, reader: lh.reader, groups: groups}
+}
+
+// LogAttrs returns the 'i18n.key' and 'i18n.locale' attributes of the key for the reader locale, e.g. to correlate
+// the logs of a message shown to a user with its catalog key
+func (cr *CatalogReader) LogAttrs(key string) []slog.Attr {
+	return []slog.Attr{slog.String(LogKeyAttribute, key), slog.String(LogLocaleAttribute, cr.Locale())}
+}
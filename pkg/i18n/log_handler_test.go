@@ -0,0 +1,106 @@
+package i18n_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"reflect"
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestLogHandler(t *testing.T) {
+	catalog := i18n.NewCatalog().WithDefaultLocale("en")
+	catalog.AddKeyValue("en", i18n.NewKeyPair("log.signup", "User {user} signed up from {country}"))
+	catalog.AddKeyValue("en", i18n.NewKeyPair("error.not_found", "{0} was not found"))
+	catalog.AddKeyValue("fr", i18n.NewKeyPair("log.signup", "Inscription de {user} depuis {country}"))
+
+	var buffer bytes.Buffer
+	jsonHandler := slog.NewJSONHandler(&buffer, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return attr
+		},
+	})
+	reader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("en")
+	logger := slog.New(i18n.NewLogHandler(jsonHandler, reader)).With("service", "accounts")
+
+	tests := []struct {
+		log      func()
+		expected map[string]any
+	}{
+		{
+			func() { logger.Info("log.signup", "user", "bob", "country", "FR") },
+			map[string]any{"level": "INFO", "msg": "User bob signed up from FR", "service": "accounts", "user": "bob", "country": "FR",
+				"i18n.key": "log.signup", "i18n.locale": "en"},
+		},
+		{
+			func() {
+				logger.Error("failed to load report", "error", fmt.Errorf("load: %w", i18n.WrapLocalizedError(fs.ErrNotExist, "error.not_found", "report.pdf")))
+			},
+			map[string]any{"level": "ERROR", "msg": "failed to load report", "service": "accounts", "error": "report.pdf was not found: file does not exist",
+				"i18n.key": "error.not_found", "i18n.locale": "en"},
+		},
+		{
+			func() { logger.Warn("plain message", "count", 2) },
+			map[string]any{"level": "WARN", "msg": "plain message", "service": "accounts", "count": float64(2)},
+		},
+	}
+
+	for _, test := range tests {
+		buffer.Reset()
+		test.log()
+
+		var entry map[string]any
+		if err := json.Unmarshal(buffer.Bytes(), &entry); err != nil {
+			t.Fatalf("unexpected error decoding log entry '%s'; %v", buffer.String(), err)
+		}
+		if !reflect.DeepEqual(entry, test.expected) {
+			t.Errorf("expected %v but got %v", test.expected, entry)
+		}
+	}
+}
+
+func TestCatalogReaderLogAttrs(t *testing.T) {
+	attrs := i18n.NewCatalogReader().WithLocale("fr").LogAttrs("log.signup")
+
+	expected := []slog.Attr{slog.String(i18n.LogKeyAttribute, "log.signup"), slog.String(i18n.LogLocaleAttribute, "fr")}
+	if len(attrs) != len(expected) || !attrs[0].Equal(expected[0]) || !attrs[1].Equal(expected[1]) {
+		t.Errorf("expected %v but got %v", expected, attrs)
+	}
+}
+
+func TestLogHandlerWithGroup(t *testing.T) {
+	catalog := i18n.NewCatalog().WithDefaultLocale("en")
+	catalog.AddKeyValue("en", i18n.NewKeyPair("log.signup", "User {user} signed up"))
+
+	var buffer bytes.Buffer
+	jsonHandler := slog.NewJSONHandler(&buffer, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return attr
+		},
+	})
+	reader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("en")
+	logger := slog.New(i18n.NewLogHandler(jsonHandler, reader)).With("service", "accounts").WithGroup("req").With("id", "42")
+
+	logger.Info("log.signup", "user", "bob")
+
+	var entry map[string]any
+	if err := json.Unmarshal(buffer.Bytes(), &entry); err != nil {
+		t.Fatalf("unexpected error decoding log entry '%s'; %v", buffer.String(), err)
+	}
+
+	expected := map[string]any{"level": "INFO", "msg": "User bob signed up", "service": "accounts",
+		"req": map[string]any{"id": "42", "user": "bob"}, "i18n.key": "log.signup", "i18n.locale": "en"}
+	if !reflect.DeepEqual(entry, expected) {
+		t.Errorf("expected %v but got %v", expected, entry)
+	}
+}
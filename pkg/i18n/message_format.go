@@ -0,0 +1,545 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidMessageFormat = errors.New("invalid message format")
+
+// MessageFormat is a compiled ICU MessageFormat message supporting simple, number, date, time, plural, selectordinal
+// and select arguments, e.g. '{count, plural, one {# file} other {# files}}'
+type MessageFormat struct {
+	nodes []messageNode
+}
+
+type messageNode interface {
+	format(state *messageState, sb *strings.Builder) error
+}
+
+type messageState struct {
+	locale    string
+	args      Args
+	number    []float64
+	formatArg func(...any) string
+}
+
+// CompileMessageFormat parses the specified value as an ICU MessageFormat message
+func CompileMessageFormat(value string) (*MessageFormat, error) {
+	p := &messageParser{input: value}
+
+	nodes, err := p.parseMessage(0, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.input) {
+		return nil, p.errorf("unexpected '%c'", p.input[p.pos])
+	}
+
+	return &MessageFormat{nodes: nodes}, nil
+}
+
+// ValidateMessageFormat is a KeyValueValidator that rejects values that are not valid ICU MessageFormat messages
+func ValidateMessageFormat(locale string, keyValue KeyValue) error {
+	_, err := CompileMessageFormat(keyValue.Value())
+	return err
+}
+
+// Format renders the message for the specified locale using the specified arguments
+func (mf *MessageFormat) Format(locale string, args Args) (string, error) {
+	return mf.format(&messageState{locale: locale, args: args})
+}
+
+func (mf *MessageFormat) format(state *messageState) (string, error) {
+	var sb strings.Builder
+	if err := formatMessageNodes(mf.nodes, state, &sb); err != nil {
+		return sb.String(), err
+	}
+
+	return sb.String(), nil
+}
+
+func formatMessageNodes(nodes []messageNode, state *messageState, sb *strings.Builder) error {
+	var errs []error
+	for _, node := range nodes {
+		if err := node.format(state, sb); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type textNode string
+
+func (n textNode) format(state *messageState, sb *strings.Builder) error {
+	sb.WriteString(string(n))
+	return nil
+}
+
+type poundNode struct{}
+
+func (n poundNode) format(state *messageState, sb *strings.Builder) error {
+	if len(state.number) == 0 {
+		sb.WriteByte('#')
+		return nil
+	}
+
+	sb.WriteString(formatMessageNumber(state.locale, state.number[len(state.number)-1], ""))
+	return nil
+}
+
+type argumentNode struct {
+	name     string
+	argType  string
+	argStyle string
+}
+
+func (n argumentNode) format(state *messageState, sb *strings.Builder) error {
+	arg, exists := state.args[n.name]
+	if !exists {
+		sb.WriteString(fmt.Sprintf(MissingArgumentFormat, n.name))
+		return fmt.Errorf("%w: %s", ErrMissingArgument, n.name)
+	}
+
+	switch n.argType {
+	case "number":
+		number, ok := messageNumber(arg)
+		if !ok {
+			return fmt.Errorf("%w: argument '%s' is not a number", ErrInvalidMessageFormat, n.name)
+		}
+		sb.WriteString(formatMessageNumber(state.locale, number, n.argStyle))
+	case "date", "time":
+		t, ok := arg.(time.Time)
+		if !ok {
+			return fmt.Errorf("%w: argument '%s' is not a time", ErrInvalidMessageFormat, n.name)
+		}
+		sb.WriteString(formatMessageTime(state.locale, t, n.argType, n.argStyle))
+	default:
+		if state.formatArg != nil {
+			sb.WriteString(state.formatArg(arg))
+			return nil
+		}
+		sb.WriteString(fmt.Sprint(arg))
+	}
+
+	return nil
+}
+
+type pluralNode struct {
+	name    string
+	offset  float64
+	ordinal bool
+	exact   map[float64][]messageNode
+	cases   map[string][]messageNode
+}
+
+func (n pluralNode) format(state *messageState, sb *strings.Builder) error {
+	arg, exists := state.args[n.name]
+	if !exists {
+		sb.WriteString(fmt.Sprintf(MissingArgumentFormat, n.name))
+		return fmt.Errorf("%w: %s", ErrMissingArgument, n.name)
+	}
+
+	number, ok := messageNumber(arg)
+	if !ok {
+		return fmt.Errorf("%w: argument '%s' is not a number", ErrInvalidMessageFormat, n.name)
+	}
+
+	nodes, exists := n.exact[number]
+	if !exists {
+		category := messagePluralCategory(state.locale, number-n.offset, n.ordinal)
+		if nodes, exists = n.cases[category]; !exists {
+			nodes = n.cases["other"]
+		}
+	}
+
+	state.number = append(state.number, number-n.offset)
+	defer func() { state.number = state.number[:len(state.number)-1] }()
+
+	return formatMessageNodes(nodes, state, sb)
+}
+
+type selectNode struct {
+	name  string
+	cases map[string][]messageNode
+}
+
+func (n selectNode) format(state *messageState, sb *strings.Builder) error {
+	arg, exists := state.args[n.name]
+	if !exists {
+		sb.WriteString(fmt.Sprintf(MissingArgumentFormat, n.name))
+		return fmt.Errorf("%w: %s", ErrMissingArgument, n.name)
+	}
+
+	nodes, exists := n.cases[fmt.Sprint(arg)]
+	if !exists {
+		nodes = n.cases["other"]
+	}
+
+	return formatMessageNodes(nodes, state, sb)
+}
+
+// messagePluralCategory returns the plural category for the number in the locale
+func messagePluralCategory(locale string, number float64, ordinal bool) string {
+	if ordinal {
+		return string(OrdinalRules(locale).Select(number))
+	}
+	return string(CardinalRules(locale).Select(number))
+}
+
+func formatMessageNumber(locale string, number float64, style string) string {
+	nf := NewNumberFormatter(locale)
+	switch style {
+	case "integer":
+		nf.WithFractionDigits(0, 0)
+	case "percent":
+		nf.WithStyle(NumberPercent)
+	case "scientific":
+		nf.WithStyle(NumberScientific)
+	}
+	return nf.Format(number)
+}
+
+// formatMessageTime formats a date or time argument with the style (short, medium, long or full, medium by default),
+// an ICU skeleton prefixed with '::' or an explicit CLDR pattern
+func formatMessageTime(locale string, t time.Time, argType string, style string) string {
+	df := NewDateTimeFormatter(locale)
+
+	var dateTimeStyle DateTimeStyle
+	switch style {
+	case "", "medium":
+		dateTimeStyle = DateTimeMedium
+	case "short":
+		dateTimeStyle = DateTimeShort
+	case "long":
+		dateTimeStyle = DateTimeLong
+	case "full":
+		dateTimeStyle = DateTimeFull
+	default:
+		if skeleton, isSkeleton := strings.CutPrefix(style, "::"); isSkeleton {
+			return df.WithSkeleton(skeleton).Format(t)
+		}
+		return df.WithPattern(style).Format(t)
+	}
+
+	if argType == "time" {
+		return df.WithDateStyle(DateTimeNone).WithTimeStyle(dateTimeStyle).Format(t)
+	}
+	return df.WithDateStyle(dateTimeStyle).Format(t)
+}
+
+func messageNumber(arg any) (float64, bool) {
+	switch v := arg.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+type messageParser struct {
+	input string
+	pos   int
+}
+
+func (p *messageParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s at offset %d", ErrInvalidMessageFormat, fmt.Sprintf(format, args...), p.pos)
+}
+
+// parseMessage parses message text and arguments until the end of the input or, when nested, an unmatched '}'
+func (p *messageParser) parseMessage(depth int, inPlural bool) ([]messageNode, error) {
+	nodes := make([]messageNode, 0)
+
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = append(nodes, textNode(text.String()))
+			text.Reset()
+		}
+	}
+
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		switch {
+		case c == '\'':
+			p.parseQuoted(&text, inPlural)
+		case c == '{':
+			flush()
+			node, err := p.parseArgument(depth, inPlural)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, node)
+		case c == '}':
+			if depth == 0 {
+				return nil, p.errorf("unmatched '}'")
+			}
+			flush()
+			return nodes, nil
+		case c == '#' && inPlural:
+			flush()
+			nodes = append(nodes, poundNode{})
+			p.pos++
+		default:
+			text.WriteByte(c)
+			p.pos++
+		}
+	}
+
+	if depth > 0 {
+		return nil, p.errorf("unclosed '{'")
+	}
+
+	flush()
+	return nodes, nil
+}
+
+// parseQuoted handles ICU apostrophe quoting: a doubled apostrophe is a literal apostrophe and an apostrophe before a
+// syntax character starts quoted literal text up to the next single apostrophe
+func (p *messageParser) parseQuoted(text *strings.Builder, inPlural bool) {
+	p.pos++
+	if p.pos >= len(p.input) {
+		text.WriteByte('\'')
+		return
+	}
+
+	next := p.input[p.pos]
+	switch {
+	case next == '\'':
+		text.WriteByte('\'')
+		p.pos++
+		return
+	case next != '{' && next != '}' && next != '|' && !(next == '#' && inPlural):
+		text.WriteByte('\'')
+		return
+	}
+
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		p.pos++
+		if c != '\'' {
+			text.WriteByte(c)
+			continue
+		}
+		if p.pos < len(p.input) && p.input[p.pos] == '\'' {
+			text.WriteByte('\'')
+			p.pos++
+			continue
+		}
+		return
+	}
+}
+
+func (p *messageParser) skipWhitespace() {
+	for p.pos < len(p.input) && strings.IndexByte(" \t\r\n", p.input[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *messageParser) parseIdentifier() string {
+	p.skipWhitespace()
+	start := p.pos
+	for p.pos < len(p.input) && strings.IndexByte(" \t\r\n{},:", p.input[p.pos]) < 0 {
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *messageParser) expect(c byte) error {
+	p.skipWhitespace()
+	if p.pos >= len(p.input) || p.input[p.pos] != c {
+		return p.errorf("expected '%c'", c)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *messageParser) parseArgument(depth int, inPlural bool) (messageNode, error) {
+	p.pos++ // '{'
+
+	name := p.parseIdentifier()
+	if name == "" {
+		return nil, p.errorf("missing argument name")
+	}
+
+	p.skipWhitespace()
+	if p.pos < len(p.input) && p.input[p.pos] == '}' {
+		p.pos++
+		return argumentNode{name: name}, nil
+	}
+
+	if err := p.expect(','); err != nil {
+		return nil, err
+	}
+
+	argType := p.parseIdentifier()
+	switch argType {
+	case "plural", "selectordinal":
+		return p.parsePlural(name, argType == "selectordinal", depth)
+	case "select":
+		return p.parseSelect(name, depth, inPlural)
+	case "number", "date", "time":
+	default:
+		return nil, p.errorf("unknown argument type '%s'", argType)
+	}
+
+	p.skipWhitespace()
+	style := ""
+	if p.pos < len(p.input) && p.input[p.pos] == ',' {
+		p.pos++
+		p.skipWhitespace()
+		start := p.pos
+		for p.pos < len(p.input) && p.input[p.pos] != '}' && p.input[p.pos] != '{' {
+			p.pos++
+		}
+		style = strings.TrimSpace(p.input[start:p.pos])
+	}
+
+	if err := p.expect('}'); err != nil {
+		return nil, err
+	}
+
+	return argumentNode{name: name, argType: argType, argStyle: style}, nil
+}
+
+func (p *messageParser) parsePlural(name string, ordinal bool, depth int) (messageNode, error) {
+	if err := p.expect(','); err != nil {
+		return nil, err
+	}
+
+	node := pluralNode{
+		name:    name,
+		ordinal: ordinal,
+		exact:   make(map[float64][]messageNode),
+		cases:   make(map[string][]messageNode),
+	}
+
+	p.skipWhitespace()
+	if strings.HasPrefix(p.input[p.pos:], "offset:") {
+		p.pos += len("offset:")
+		offset, err := strconv.ParseFloat(p.parseIdentifier(), 64)
+		if err != nil {
+			return nil, p.errorf("invalid plural offset")
+		}
+		node.offset = offset
+	}
+
+	err := p.parseCases(depth, true, func(selector string, nodes []messageNode) error {
+		if strings.HasPrefix(selector, "=") {
+			exact, err := strconv.ParseFloat(selector[1:], 64)
+			if err != nil {
+				return p.errorf("invalid plural selector '%s'", selector)
+			}
+			node.exact[exact] = nodes
+			return nil
+		}
+
+		if !isPluralCategory(selector) {
+			return p.errorf("invalid plural selector '%s'", selector)
+		}
+		node.cases[selector] = nodes
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, exists := node.cases["other"]; !exists {
+		return nil, p.errorf("plural argument '%s' is missing the 'other' case", name)
+	}
+
+	return node, nil
+}
+
+func (p *messageParser) parseSelect(name string, depth int, inPlural bool) (messageNode, error) {
+	if err := p.expect(','); err != nil {
+		return nil, err
+	}
+
+	node := selectNode{name: name, cases: make(map[string][]messageNode)}
+
+	err := p.parseCases(depth, inPlural, func(selector string, nodes []messageNode) error {
+		node.cases[selector] = nodes
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, exists := node.cases["other"]; !exists {
+		return nil, p.errorf("select argument '%s' is missing the 'other' case", name)
+	}
+
+	return node, nil
+}
+
+// parseCases parses 'selector {message}' pairs up to and including the closing '}' of the argument
+func (p *messageParser) parseCases(depth int, inPlural bool, addCase func(string, []messageNode) error) error {
+	for {
+		p.skipWhitespace()
+		if p.pos >= len(p.input) {
+			return p.errorf("unclosed '{'")
+		}
+		if p.input[p.pos] == '}' {
+			p.pos++
+			return nil
+		}
+
+		selector := p.parseIdentifier()
+		if selector == "" {
+			return p.errorf("missing selector")
+		}
+
+		if err := p.expect('{'); err != nil {
+			return err
+		}
+
+		nodes, err := p.parseMessage(depth+1, inPlural)
+		if err != nil {
+			return err
+		}
+		p.pos++ // '}'
+
+		if err := addCase(selector, nodes); err != nil {
+			return err
+		}
+	}
+}
+
+func isPluralCategory(category string) bool {
+	switch category {
+	case "zero", "one", "two", "few", "many", "other":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,103 @@
+package i18n_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestMessageFormat(t *testing.T) {
+	tests := []struct {
+		value    string
+		args     i18n.Args
+		expected string
+	}{
+		{"Hello {name}", i18n.Args{"name": "Bob"}, "Hello Bob"},
+		{"{count, plural, one {# file} other {# files}}", i18n.Args{"count": 1}, "1 file"},
+		{"{count, plural, one {# file} other {# files}}", i18n.Args{"count": 5}, "5 files"},
+		{"{count, plural, =0 {no files} one {# file} other {# files}}", i18n.Args{"count": 0}, "no files"},
+		{"{count, plural, offset:1 =0 {nobody} one {{name} and # other} other {{name} and # others}}", i18n.Args{"count": 3, "name": "Ann"}, "Ann and 2 others"},
+		{"{gender, select, female {She} male {He} other {They}} liked it", i18n.Args{"gender": "female"}, "She liked it"},
+		{"{gender, select, female {She} male {He} other {They}} liked it", i18n.Args{"gender": "x"}, "They liked it"},
+		{"{gender, select, female {{n, plural, one {her # file} other {her # files}}} other {{n, plural, one {their # file} other {their # files}}}}", i18n.Args{"gender": "female", "n": 2}, "her 2 files"},
+		{"It''s '{literal}'", nil, "It's {literal}"},
+		{"{n, number, percent}", i18n.Args{"n": 0.25}, "25%"},
+	}
+
+	for _, test := range tests {
+		mf, err := i18n.CompileMessageFormat(test.value)
+		if err != nil {
+			t.Errorf("failed to compile '%s'; %v", test.value, err)
+			continue
+		}
+
+		v, err := mf.Format("en", test.args)
+		switch {
+		case err != nil:
+			t.Errorf("failed to format '%s'; %v", test.value, err)
+		case v != test.expected:
+			t.Errorf("expected '%s' but got '%s'", test.expected, v)
+		}
+	}
+}
+
+func TestMessageFormatErrors(t *testing.T) {
+	invalid := []string{
+		"{count",
+		"text}",
+		"{count, plural, one {# file}}",
+		"{count, plural, one {# file} other {# files}",
+		"{gender, select, male {He}}",
+		"{count, unknown}",
+		"{count, plural, single {#} other {#}}",
+	}
+
+	for _, value := range invalid {
+		if _, err := i18n.CompileMessageFormat(value); !errors.Is(err, i18n.ErrInvalidMessageFormat) {
+			t.Errorf("expected invalid message format error for '%s' but got %v", value, err)
+		}
+	}
+
+	mf, err := i18n.CompileMessageFormat("Hello {name}")
+	if err != nil {
+		t.Fatalf("failed to compile message; %v", err)
+	}
+
+	if _, err := mf.Format("en", nil); !errors.Is(err, i18n.ErrMissingArgument) {
+		t.Errorf("expected missing argument error but got %v", err)
+	}
+}
+
+type testParser []i18n.KeyPair
+
+func (tp testParser) Parse(addEntryFunc func(locale string, keyValue i18n.KeyValue)) error {
+	for _, kp := range tp {
+		addEntryFunc("test", kp)
+	}
+	return nil
+}
+
+func TestMessageFormatValidation(t *testing.T) {
+	parser := testParser{i18n.NewKeyPair("files", "{count, plural, one {# file}}")}
+
+	_, err := i18n.NewCatalog().WithParser(parser).WithValidators(i18n.ValidateMessageFormat).Initialize()
+	if !errors.Is(err, i18n.ErrInvalidMessageFormat) {
+		t.Errorf("expected initialize to fail with invalid message format but got %v", err)
+	}
+
+	parser = testParser{i18n.NewKeyPair("files", "{count, plural, one {# file} other {# files}}")}
+
+	catalog, err := i18n.NewCatalog().WithParser(parser).WithValidators(i18n.ValidateMessageFormat).Initialize()
+	if err != nil {
+		t.Fatalf("unexpected error initializing catalog; %v", err)
+	}
+
+	v, err := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("test").FormatMessage("files", i18n.Args{"count": 2})
+	switch {
+	case err != nil:
+		t.Errorf("failed to format message; %v", err)
+	case v != "2 files":
+		t.Errorf("expected '2 files' but got '%s'", v)
+	}
+}
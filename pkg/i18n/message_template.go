@@ -0,0 +1,154 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Args holds named arguments for formatting a message template
+type Args map[string]any
+
+const MissingArgumentFormat = "[missing arg:%s]"
+
+var (
+	ErrMissingArgument = errors.New("missing argument")
+	ErrInvalidTemplate = errors.New("invalid template")
+)
+
+// MessageTemplate is a compiled message value containing '{name}' or '{0}' placeholders; literal braces are escaped
+// by doubling them ('{{' and '}}')
+type MessageTemplate struct {
+	parts []templatePart
+}
+
+type templatePart struct {
+	literal     string
+	placeholder string
+	position    int
+}
+
+// CompileMessageTemplate compiles the specified message value into a MessageTemplate
+func CompileMessageTemplate(value string) (*MessageTemplate, error) {
+	mt := &MessageTemplate{}
+
+	var literal strings.Builder
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '{':
+			if i+1 < len(value) && value[i+1] == '{' {
+				literal.WriteByte('{')
+				i++
+				continue
+			}
+
+			end := strings.IndexByte(value[i+1:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unclosed placeholder at offset %d", ErrInvalidTemplate, i)
+			}
+
+			name := strings.TrimSpace(value[i+1 : i+1+end])
+			if name == "" || strings.ContainsAny(name, "{") {
+				return nil, fmt.Errorf("%w: invalid placeholder at offset %d", ErrInvalidTemplate, i)
+			}
+
+			if literal.Len() > 0 {
+				mt.parts = append(mt.parts, templatePart{literal: literal.String(), position: -1})
+				literal.Reset()
+			}
+
+			position := -1
+			if n, err := strconv.Atoi(name); err == nil && n >= 0 {
+				position = n
+			}
+			mt.parts = append(mt.parts, templatePart{placeholder: name, position: position})
+
+			i += end + 1
+		case '}':
+			if i+1 < len(value) && value[i+1] == '}' {
+				literal.WriteByte('}')
+				i++
+				continue
+			}
+			return nil, fmt.Errorf("%w: unmatched '}' at offset %d", ErrInvalidTemplate, i)
+		default:
+			literal.WriteByte(value[i])
+		}
+	}
+
+	if literal.Len() > 0 {
+		mt.parts = append(mt.parts, templatePart{literal: literal.String(), position: -1})
+	}
+
+	return mt, nil
+}
+
+// Placeholders returns the placeholder names used by the template in order of appearance
+func (mt *MessageTemplate) Placeholders() []string {
+	names := make([]string, 0)
+	for _, part := range mt.parts {
+		if part.placeholder != "" {
+			names = append(names, part.placeholder)
+		}
+	}
+	return names
+}
+
+// Execute renders the template; Args arguments supply named placeholders while all other arguments supply positional
+// placeholders, and each missing argument is rendered using MissingArgumentFormat and reported in the returned error
+func (mt *MessageTemplate) Execute(args ...any) (string, error) {
+	return mt.execute(fmt.Sprint, args...)
+}
+
+func (mt *MessageTemplate) execute(formatArg func(...any) string, args ...any) (string, error) {
+	named, positional := splitArgs(args)
+
+	var sb strings.Builder
+	var missing []string
+	for _, part := range mt.parts {
+		if part.placeholder == "" {
+			sb.WriteString(part.literal)
+			continue
+		}
+
+		arg, exists := named[part.placeholder]
+		if !exists && part.position >= 0 && part.position < len(positional) {
+			arg, exists = positional[part.position], true
+		}
+
+		if !exists {
+			missing = append(missing, part.placeholder)
+			sb.WriteString(fmt.Sprintf(MissingArgumentFormat, part.placeholder))
+			continue
+		}
+
+		sb.WriteString(formatArg(arg))
+	}
+
+	if len(missing) > 0 {
+		return sb.String(), fmt.Errorf("%w: %s", ErrMissingArgument, strings.Join(missing, ", "))
+	}
+
+	return sb.String(), nil
+}
+
+func splitArgs(args []any) (Args, []any) {
+	named := Args{}
+	positional := make([]any, 0, len(args))
+	for _, arg := range args {
+		switch a := arg.(type) {
+		case Args:
+			for k, v := range a {
+				named[k] = v
+			}
+		case map[string]any:
+			for k, v := range a {
+				named[k] = v
+			}
+		default:
+			positional = append(positional, arg)
+		}
+	}
+	return named, positional
+}
@@ -0,0 +1,76 @@
+package i18n_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestMessageTemplate(t *testing.T) {
+	tests := []struct {
+		value    string
+		args     []any
+		expected string
+	}{
+		{"Hello {name}!", []any{i18n.Args{"name": "Bob"}}, "Hello Bob!"},
+		{"{0} of {1}", []any{1, 3}, "1 of 3"},
+		{"{ name } has {0} files", []any{i18n.Args{"name": "Ann"}, 2}, "Ann has 2 files"},
+		{"{{literal}} {0}", []any{"x"}, "{literal} x"},
+		{"no placeholders", nil, "no placeholders"},
+	}
+
+	for _, test := range tests {
+		mt, err := i18n.CompileMessageTemplate(test.value)
+		if err != nil {
+			t.Errorf("failed to compile '%s'; %v", test.value, err)
+			continue
+		}
+
+		v, err := mt.Execute(test.args...)
+		switch {
+		case err != nil:
+			t.Errorf("failed to execute '%s'; %v", test.value, err)
+		case v != test.expected:
+			t.Errorf("expected '%s' but got '%s'", test.expected, v)
+		}
+	}
+}
+
+func TestMessageTemplateErrors(t *testing.T) {
+	for _, value := range []string{"{name", "name}", "{}"} {
+		if _, err := i18n.CompileMessageTemplate(value); !errors.Is(err, i18n.ErrInvalidTemplate) {
+			t.Errorf("expected invalid template error for '%s' but got %v", value, err)
+		}
+	}
+
+	mt, err := i18n.CompileMessageTemplate("Hello {name}, {0}")
+	if err != nil {
+		t.Fatalf("failed to compile template; %v", err)
+	}
+
+	v, err := mt.Execute()
+	switch {
+	case !errors.Is(err, i18n.ErrMissingArgument):
+		t.Errorf("expected missing argument error but got %v", err)
+	case v != "Hello [missing arg:name], [missing arg:0]":
+		t.Errorf("unexpected missing argument output '%s'", v)
+	}
+}
+
+func TestReaderFormat(t *testing.T) {
+	catalog := i18n.NewCatalog()
+	catalog.AddKeyValue("test", i18n.NewKeyPair("greeting", "Hello {name}, you have {0} messages"))
+
+	catalogReader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("test")
+
+	for i := 0; i < 2; i++ {
+		v, err := catalogReader.Format("greeting", i18n.Args{"name": "Bob"}, 3)
+		switch {
+		case err != nil:
+			t.Errorf("failed to format greeting; %v", err)
+		case v != "Hello Bob, you have 3 messages":
+			t.Errorf("unexpected formatted value '%s'", v)
+		}
+	}
+}
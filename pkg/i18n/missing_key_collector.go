@@ -0,0 +1,225 @@
+package i18n
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MissingKeyKind identifies why a lookup was recorded by a MissingKeyCollector
+type MissingKeyKind string
+
+const (
+	MissingKeyKindMiss     = MissingKeyKind("miss")
+	MissingKeyKindFallback = MissingKeyKind("fallback")
+
+	DefaultMissingKeyCollectorSize = 1000
+)
+
+// MissingKeyRecord describes a locale and key that was missing or resolved through a fallback locale
+type MissingKeyRecord struct {
+	Kind           MissingKeyKind `json:"kind"`
+	Locale         string         `json:"locale"`
+	Key            string         `json:"key"`
+	FallbackLocale string         `json:"fallbackLocale,omitempty"`
+	Count          int64          `json:"count"`
+	FirstSeen      time.Time      `json:"firstSeen"`
+	LastSeen       time.Time      `json:"lastSeen"`
+}
+
+type missingKeyRecordID struct {
+	kind   MissingKeyKind
+	locale string
+	key    string
+}
+
+// MissingKeyCollector records missing keys and fallbacks, keeping at most a fixed number of records by evicting the
+// least recently seen record
+type MissingKeyCollector struct {
+	maxRecords int
+	records    map[missingKeyRecordID]*list.Element
+	order      *list.List
+	evicted    int64
+
+	lock sync.Mutex
+}
+
+// NewMissingKeyCollector returns a new collector that keeps at most maxRecords records
+// (DefaultMissingKeyCollectorSize when maxRecords is not positive)
+func NewMissingKeyCollector(maxRecords int) *MissingKeyCollector {
+	if maxRecords <= 0 {
+		maxRecords = DefaultMissingKeyCollectorSize
+	}
+
+	return &MissingKeyCollector{
+		maxRecords: maxRecords,
+		records:    make(map[missingKeyRecordID]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// RecordMiss records that the key could not be found for the locale
+func (mkc *MissingKeyCollector) RecordMiss(locale string, key string) {
+	mkc.record(MissingKeyKindMiss, locale, "", key)
+}
+
+// RecordFallback records that the key was resolved using the fallback locale instead of the locale
+func (mkc *MissingKeyCollector) RecordFallback(locale string, fallbackLocale string, key string) {
+	mkc.record(MissingKeyKindFallback, locale, fallbackLocale, key)
+}
+
+func (mkc *MissingKeyCollector) record(kind MissingKeyKind, locale string, fallbackLocale string, key string) {
+	if mkc == nil {
+		return
+	}
+
+	now := time.Now()
+
+	mkc.lock.Lock()
+	defer mkc.lock.Unlock()
+
+	id := missingKeyRecordID{kind: kind, locale: locale, key: key}
+	if element, exists := mkc.records[id]; exists {
+		record := element.Value.(*MissingKeyRecord)
+		record.Count++
+		record.LastSeen = now
+		record.FallbackLocale = fallbackLocale
+		mkc.order.MoveToFront(element)
+		return
+	}
+
+	if mkc.order.Len() >= mkc.maxRecords {
+		oldest := mkc.order.Back()
+		record := mkc.order.Remove(oldest).(*MissingKeyRecord)
+		delete(mkc.records, missingKeyRecordID{kind: record.Kind, locale: record.Locale, key: record.Key})
+		mkc.evicted++
+	}
+
+	mkc.records[id] = mkc.order.PushFront(&MissingKeyRecord{
+		Kind:           kind,
+		Locale:         locale,
+		Key:            key,
+		FallbackLocale: fallbackLocale,
+		Count:          1,
+		FirstSeen:      now,
+		LastSeen:       now,
+	})
+}
+
+// Snapshot returns a copy of the current records sorted by locale, key and kind
+func (mkc *MissingKeyCollector) Snapshot() []MissingKeyRecord {
+	if mkc == nil {
+		return []MissingKeyRecord{}
+	}
+
+	mkc.lock.Lock()
+	records := make([]MissingKeyRecord, 0, mkc.order.Len())
+	for element := mkc.order.Front(); element != nil; element = element.Next() {
+		records = append(records, *element.Value.(*MissingKeyRecord))
+	}
+	mkc.lock.Unlock()
+
+	sort.Slice(records, func(i, j int) bool {
+		switch {
+		case records[i].Locale != records[j].Locale:
+			return records[i].Locale < records[j].Locale
+		case records[i].Key != records[j].Key:
+			return records[i].Key < records[j].Key
+		default:
+			return records[i].Kind < records[j].Kind
+		}
+	})
+
+	return records
+}
+
+// Evicted returns the number of records dropped to keep the collector within its bounds
+func (mkc *MissingKeyCollector) Evicted() int64 {
+	if mkc == nil {
+		return 0
+	}
+
+	mkc.lock.Lock()
+	defer mkc.lock.Unlock()
+
+	return mkc.evicted
+}
+
+// Reset removes all records
+func (mkc *MissingKeyCollector) Reset() {
+	if mkc == nil {
+		return
+	}
+
+	mkc.lock.Lock()
+	defer mkc.lock.Unlock()
+
+	mkc.records = make(map[missingKeyRecordID]*list.Element)
+	mkc.order.Init()
+	mkc.evicted = 0
+}
+
+// WriteCSV writes the current records to the writer as CSV, including a header row
+func (mkc *MissingKeyCollector) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"kind", "locale", "key", "fallback_locale", "count", "first_seen", "last_seen"}); err != nil {
+		return fmt.Errorf("failed to write csv header: %w", err)
+	}
+
+	for _, record := range mkc.Snapshot() {
+		row := []string{
+			string(record.Kind),
+			record.Locale,
+			record.Key,
+			record.FallbackLocale,
+			strconv.FormatInt(record.Count, 10),
+			record.FirstSeen.UTC().Format(time.RFC3339),
+			record.LastSeen.UTC().Format(time.RFC3339),
+		}
+		if err := cw.Write(row); err != nil {
+			return fmt.Errorf("failed to write csv row: %w", err)
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the current records to the writer as a JSON array
+func (mkc *MissingKeyCollector) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(mkc.Snapshot())
+}
+
+// ServeHTTP serves the current records as a downloadable report; CSV by default or JSON when the 'format' query
+// parameter is 'json'; the report is rendered before any header is written
+func (mkc *MissingKeyCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var buffer bytes.Buffer
+	contentType, fileName := "text/csv; charset=utf-8", "missing-keys.csv"
+
+	var err error
+	switch r.URL.Query().Get("format") {
+	case "json":
+		contentType, fileName = "application/json", "missing-keys.json"
+		err = mkc.WriteJSON(&buffer)
+	default:
+		err = mkc.WriteCSV(&buffer)
+	}
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+fileName+`"`)
+	_, _ = buffer.WriteTo(w)
+}
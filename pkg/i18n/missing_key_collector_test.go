@@ -0,0 +1,79 @@
+package i18n_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestCollectorRecords(t *testing.T) {
+	catalog, _, err := newTestCatalogAndContext()
+	if err != nil {
+		t.Fatalf("unexpected error creating test catalog and context; %v", err)
+	}
+
+	collector := i18n.NewMissingKeyCollector(10)
+	catalog.WithDefaultLocale("test").WithCollector(collector)
+
+	_ = catalog.Get("test", testKey)
+	_ = catalog.Get("test", "invalid")
+	_ = catalog.Get("test", "invalid")
+	_ = catalog.Get("other", testKey)
+
+	records := collector.Snapshot()
+	switch {
+	case len(records) != 2:
+		t.Fatalf("expected 2 records but got %d", len(records))
+	case records[0].Kind != i18n.MissingKeyKindFallback || records[0].Locale != "other" || records[0].FallbackLocale != "test":
+		t.Errorf("expected fallback record for 'other' but got %+v", records[0])
+	case records[1].Kind != i18n.MissingKeyKindMiss || records[1].Key != "invalid" || records[1].Count != 2:
+		t.Errorf("expected miss record for 'invalid' with count 2 but got %+v", records[1])
+	case records[1].FirstSeen.After(records[1].LastSeen):
+		t.Errorf("expected first seen to be before last seen but got %+v", records[1])
+	}
+}
+
+func TestCollectorBounds(t *testing.T) {
+	collector := i18n.NewMissingKeyCollector(2)
+
+	collector.RecordMiss("test", "key-1")
+	collector.RecordMiss("test", "key-2")
+	collector.RecordMiss("test", "key-1")
+	collector.RecordMiss("test", "key-3")
+
+	records := collector.Snapshot()
+	switch {
+	case len(records) != 2:
+		t.Fatalf("expected 2 records but got %d", len(records))
+	case records[0].Key != "key-1" || records[1].Key != "key-3":
+		t.Errorf("expected least recently seen record to be evicted but got %+v", records)
+	case collector.Evicted() != 1:
+		t.Errorf("expected 1 evicted record but got %d", collector.Evicted())
+	}
+}
+
+func TestCollectorReport(t *testing.T) {
+	collector := i18n.NewMissingKeyCollector(0)
+	collector.RecordMiss("test", "invalid")
+
+	rec := httptest.NewRecorder()
+	collector.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n"); len(lines) != 2 || !strings.HasPrefix(lines[1], "miss,test,invalid,,1,") {
+		t.Errorf("unexpected csv report '%s'", rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	collector.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?format=json", nil))
+
+	var records []i18n.MissingKeyRecord
+	if err := json.Unmarshal(rec.Body.Bytes(), &records); err != nil {
+		t.Fatalf("failed to unmarshal json report; %v", err)
+	}
+	if len(records) != 1 || records[0].Key != "invalid" {
+		t.Errorf("unexpected json report %+v", records)
+	}
+}
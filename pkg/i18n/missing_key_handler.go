@@ -0,0 +1,64 @@
+package i18n
+
+import "fmt"
+
+// MissingKeyHandler is called with the requested locale and key whenever a key can not be found, returning the
+// KeyValue to use in its place
+type MissingKeyHandler func(locale string, key string) KeyValue
+
+// MissingKeyAsUnknown returns a KeyValue using the UnknownKeyFormat; this is the default handler
+func MissingKeyAsUnknown(locale string, key string) KeyValue {
+	return NewUnknownKeyPair(key)
+}
+
+// MissingKeyAsKey returns a KeyValue whose value is the key itself
+func MissingKeyAsKey(locale string, key string) KeyValue {
+	return NewKeyPair(key, key)
+}
+
+// MissingKeyAsEmpty returns a KeyValue whose value is an empty string
+func MissingKeyAsEmpty(locale string, key string) KeyValue {
+	return NewKeyPair(key, "")
+}
+
+// MissingKeyPanic panics with the requested locale and key; useful for surfacing missing keys in tests
+func MissingKeyPanic(locale string, key string) KeyValue {
+	panic(fmt.Sprintf("i18n: missing key '%s' for locale '%s'", key, locale))
+}
+
+// MissingKeyWithFormat returns a handler that formats the value using the specified format, which is passed the
+// locale and key (in that order)
+func MissingKeyWithFormat(format string) MissingKeyHandler {
+	return func(locale string, key string) KeyValue {
+		return NewKeyPair(key, fmt.Sprintf(format, locale, key))
+	}
+}
+
+// MissingKeyFromLocale returns a handler that uses the value from the specified catalog locale, falling back to the
+// specified handler (or MissingKeyAsUnknown when nil) if the key is missing there as well
+func MissingKeyFromLocale(catalog *Catalog, fallbackLocale string, fallback MissingKeyHandler) MissingKeyHandler {
+	if fallback == nil {
+		fallback = MissingKeyAsUnknown
+	}
+
+	return func(locale string, key string) KeyValue {
+		if value, exists := catalog.Lookup(fallbackLocale, key); exists {
+			return value
+		}
+		return fallback(locale, key)
+	}
+}
+
+// WithLogging returns a handler that calls logFunc with the requested locale and key before delegating to handler
+func WithLogging(handler MissingKeyHandler, logFunc func(locale string, key string)) MissingKeyHandler {
+	if handler == nil {
+		handler = MissingKeyAsUnknown
+	}
+
+	return func(locale string, key string) KeyValue {
+		if logFunc != nil {
+			logFunc(locale, key)
+		}
+		return handler(locale, key)
+	}
+}
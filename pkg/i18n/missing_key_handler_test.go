@@ -0,0 +1,83 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestMissingKeyHandlers(t *testing.T) {
+	catalog, _, err := newTestCatalogAndContext()
+	if err != nil {
+		t.Fatalf("unexpected error creating test catalog and context; %v", err)
+	}
+
+	v := catalog.WithMissingKeyHandler(i18n.MissingKeyAsKey).Get("test", "invalid")
+	if v.Value() != "invalid" {
+		t.Errorf("expected key as value but got '%s'", v.Value())
+	}
+
+	v = catalog.WithMissingKeyHandler(i18n.MissingKeyAsEmpty).Get("test", "invalid")
+	if v.Value() != "" {
+		t.Errorf("expected empty value but got '%s'", v.Value())
+	}
+
+	v = catalog.WithMissingKeyHandler(i18n.MissingKeyWithFormat("!%s:%s!")).Get("test", "invalid")
+	if v.Value() != "!test:invalid!" {
+		t.Errorf("expected formatted value but got '%s'", v.Value())
+	}
+
+	catalog.AddKeyValue("other", i18n.NewKeyPair("other-key", "other-value"))
+	v = catalog.WithMissingKeyHandler(i18n.MissingKeyFromLocale(catalog, "test", nil)).Get("other", testKey)
+	if v.Value() != testValue {
+		t.Errorf("expected value from fallback locale but got '%s'", v.Value())
+	}
+}
+
+func TestMissingKeyHandlerLogging(t *testing.T) {
+	catalog, _, err := newTestCatalogAndContext()
+	if err != nil {
+		t.Fatalf("unexpected error creating test catalog and context; %v", err)
+	}
+
+	var gotLocale, gotKey string
+	catalog.WithMissingKeyHandler(i18n.WithLogging(nil, func(locale string, key string) {
+		gotLocale, gotKey = locale, key
+	}))
+
+	_ = catalog.Get("test", "invalid")
+	if gotLocale != "test" || gotKey != "invalid" {
+		t.Errorf("expected handler to receive 'test' and 'invalid' but got '%s' and '%s'", gotLocale, gotKey)
+	}
+
+	if v := catalog.Get("test", testKey); v.Value() != testValue {
+		t.Errorf("failed to get proper value for key; expected '%s' but got '%s'", testValue, v.Value())
+	}
+}
+
+func TestMissingKeyPanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for missing key")
+		}
+	}()
+
+	i18n.NewCatalog().WithMissingKeyHandler(i18n.MissingKeyPanic).Get("test", "invalid")
+}
+
+func TestReaderMissingKeyHandler(t *testing.T) {
+	catalog, _, err := newTestCatalogAndContext()
+	if err != nil {
+		t.Fatalf("unexpected error creating test catalog and context; %v", err)
+	}
+
+	catalogReader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("test").WithMissingKeyHandler(i18n.MissingKeyAsKey)
+
+	if v := catalogReader.Get("invalid"); v.Value() != "invalid" {
+		t.Errorf("expected key as value but got '%s'", v.Value())
+	}
+
+	if v := catalogReader.Get(testKey); v.Value() != testValue {
+		t.Errorf("failed to get proper value for key; expected '%s' but got '%s'", testValue, v.Value())
+	}
+}
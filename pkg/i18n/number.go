@@ -0,0 +1,438 @@
+package i18n
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// NumberStyle determines how a NumberFormatter renders numbers
+type NumberStyle int
+
+const (
+	NumberDecimal NumberStyle = iota
+	NumberPercent
+	NumberScientific
+	NumberCurrency
+	NumberAccounting
+	NumberCompact
+	NumberByteSize
+	NumberBinaryByteSize
+)
+
+// NativeNumberingSystem selects the native digits of the locale, e.g. 'thai' for 'th' or 'deva' for 'hi'
+const NativeNumberingSystem = "native"
+
+var numberingSystems = map[string][]rune{
+	"latn":     []rune("0123456789"),
+	"arab":     []rune("٠١٢٣٤٥٦٧٨٩"),
+	"arabext":  []rune("۰۱۲۳۴۵۶۷۸۹"),
+	"beng":     []rune("০১২৩৪৫৬৭৮৯"),
+	"deva":     []rune("०१२३४५६७८९"),
+	"fullwide": []rune("０１２３４５６７８９"),
+	"hanidec":  []rune("〇一二三四五六七八九"),
+	"mymr":     []rune("၀၁၂၃၄၅၆၇၈၉"),
+	"tamldec":  []rune("௦௧௨௩௪௫௬௭௮௯"),
+	"thai":     []rune("๐๑๒๓๔๕๖๗๘๙"),
+}
+
+type numberSymbols struct {
+	numberingSystem       string
+	nativeNumberingSystem string
+
+	decimal string
+	group   string
+	minus   string
+	percent string
+
+	decimalPattern numberPattern
+	percentPattern numberPattern
+
+	minimumGroupingDigits int
+}
+
+// numberPattern is a parsed CLDR number pattern such as '#,##0.###', '#,##0%' or '¤#,##0.00;(¤#,##0.00)'
+type numberPattern struct {
+	prefix string
+	suffix string
+
+	negativePrefix string
+	negativeSuffix string
+	hasNegative    bool
+
+	primaryGroup   int
+	secondaryGroup int
+
+	minimumInteger  int
+	minimumFraction int
+	maximumFraction int
+}
+
+func parseNumberPattern(pattern string) numberPattern {
+	pattern, negative, hasNegative := strings.Cut(pattern, ";")
+
+	start := strings.IndexAny(pattern, "#0,.")
+	end := strings.LastIndexAny(pattern, "#0,.") + 1
+	if start < 0 {
+		return numberPattern{prefix: pattern}
+	}
+
+	np := numberPattern{prefix: pattern[:start], suffix: pattern[end:]}
+
+	if hasNegative {
+		negativeStart := strings.IndexAny(negative, "#0,.")
+		negativeEnd := strings.LastIndexAny(negative, "#0,.") + 1
+		if negativeStart >= 0 {
+			np.negativePrefix, np.negativeSuffix, np.hasNegative = negative[:negativeStart], negative[negativeEnd:], true
+		}
+	}
+
+	integer, fraction, _ := strings.Cut(pattern[start:end], ".")
+	np.minimumInteger = strings.Count(integer, "0")
+	np.minimumFraction = strings.Count(fraction, "0")
+	np.maximumFraction = len(fraction)
+
+	groups := strings.Split(integer, ",")
+	if len(groups) > 1 {
+		np.primaryGroup = len(groups[len(groups)-1])
+		np.secondaryGroup = np.primaryGroup
+	}
+	if len(groups) > 2 {
+		np.secondaryGroup = len(groups[len(groups)-2])
+	}
+
+	return np
+}
+
+// affixes returns the prefix and suffix for a positive or negative number with the placeholder symbols replaced by
+// the localized symbols and the currency symbol
+func (np numberPattern) affixes(symbols *numberSymbols, negative bool, currency string) (string, string) {
+	prefix, suffix := np.prefix, np.suffix
+	switch {
+	case negative && np.hasNegative:
+		prefix, suffix = np.negativePrefix, np.negativeSuffix
+	case negative:
+		prefix = "-" + prefix
+	}
+
+	replacer := strings.NewReplacer("-", symbols.minus, "%", symbols.percent, "¤", currency)
+	return currencySpacing(replacer.Replace(prefix), prefix, currency, true), currencySpacing(replacer.Replace(suffix), suffix, currency, false)
+}
+
+// currencySpacing inserts a no-break space between the number and a currency symbol that is adjacent to it and
+// starts or ends with a letter, e.g. 'USD 1.00' instead of 'USD1.00'
+func currencySpacing(affix string, pattern string, currency string, isPrefix bool) string {
+	if currency == "" {
+		return affix
+	}
+
+	runes := []rune(currency)
+	switch {
+	case isPrefix && strings.HasSuffix(pattern, "¤") && unicode.IsLetter(runes[len(runes)-1]):
+		return affix + "\u00a0"
+	case !isPrefix && strings.HasPrefix(pattern, "¤") && unicode.IsLetter(runes[0]):
+		return "\u00a0" + affix
+	default:
+		return affix
+	}
+}
+
+//go:embed data/numbers.txt
+var numbersData string
+
+var (
+	numberSymbolsOnce sync.Once
+	numberSymbolsData map[string]*numberSymbols
+
+	_numberDataRegex = regexp.MustCompile(`"[^"]*"|\S+`)
+)
+
+func loadNumberSymbols() {
+	numberSymbolsData = make(map[string]*numberSymbols)
+
+	scanner := bufio.NewScanner(strings.NewReader(numbersData))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := _numberDataRegex.FindAllString(line, -1)
+		if len(fields) != 10 {
+			panic(fmt.Sprintf("i18n: invalid number data line '%s'", line))
+		}
+
+		for i, field := range fields {
+			if strings.HasPrefix(field, `"`) {
+				unquoted, err := strconv.Unquote(field)
+				if err != nil {
+					panic(fmt.Sprintf("i18n: invalid number data field '%s': %v", field, err))
+				}
+				fields[i] = unquoted
+			}
+		}
+
+		minimumGroupingDigits, err := strconv.Atoi(fields[9])
+		if err != nil {
+			panic(fmt.Sprintf("i18n: invalid number data line '%s'", line))
+		}
+
+		numberSymbolsData[fields[0]] = &numberSymbols{
+			numberingSystem:       fields[1],
+			nativeNumberingSystem: fields[2],
+			decimal:               fields[3],
+			group:                 fields[4],
+			minus:                 fields[5],
+			percent:               fields[6],
+			decimalPattern:        parseNumberPattern(fields[7]),
+			percentPattern:        parseNumberPattern(fields[8]),
+			minimumGroupingDigits: minimumGroupingDigits,
+		}
+	}
+}
+
+func numberSymbolsFor(locale string) *numberSymbols {
+	numberSymbolsOnce.Do(loadNumberSymbols)
+
+	for _, candidate := range localeCandidates(locale) {
+		if symbols, exists := numberSymbolsData[candidate]; exists {
+			return symbols
+		}
+	}
+	return numberSymbolsData["root"]
+}
+
+// NumberFormatter formats numbers using the CLDR symbols and patterns of a locale
+type NumberFormatter struct {
+	locale  string
+	symbols *numberSymbols
+
+	style           NumberStyle
+	minimumInteger  int
+	minimumFraction int
+	maximumFraction int
+	fractionSet     bool
+	grouping        bool
+	numberingSystem string
+
+	currency        string
+	currencyDisplay CurrencyDisplay
+
+	width Width
+}
+
+// NewNumberFormatter returns a new decimal NumberFormatter for the specified locale
+func NewNumberFormatter(locale string) *NumberFormatter {
+	symbols := numberSymbolsFor(locale)
+	return &NumberFormatter{
+		locale:          locale,
+		symbols:         symbols,
+		minimumInteger:  1,
+		grouping:        true,
+		numberingSystem: symbols.numberingSystem,
+	}
+}
+
+// WithStyle sets the style used when formatting numbers
+func (nf *NumberFormatter) WithStyle(style NumberStyle) *NumberFormatter {
+	if nf != nil {
+		nf.style = style
+	}
+	return nf
+}
+
+// WithFractionDigits sets the minimum and maximum number of fraction digits, overriding the locale pattern
+func (nf *NumberFormatter) WithFractionDigits(minimum int, maximum int) *NumberFormatter {
+	if nf != nil {
+		nf.minimumFraction = max(minimum, 0)
+		nf.maximumFraction = max(maximum, nf.minimumFraction)
+		nf.fractionSet = true
+	}
+	return nf
+}
+
+// WithMinimumIntegerDigits sets the minimum number of integer digits, padding with zeros
+func (nf *NumberFormatter) WithMinimumIntegerDigits(minimum int) *NumberFormatter {
+	if nf != nil {
+		nf.minimumInteger = max(minimum, 1)
+	}
+	return nf
+}
+
+// WithGrouping enables or disables grouping separators
+func (nf *NumberFormatter) WithGrouping(grouping bool) *NumberFormatter {
+	if nf != nil {
+		nf.grouping = grouping
+	}
+	return nf
+}
+
+// WithWidth sets the width used by the NumberCompact, NumberByteSize and NumberBinaryByteSize styles
+func (nf *NumberFormatter) WithWidth(width Width) *NumberFormatter {
+	if nf != nil {
+		nf.width = width
+	}
+	return nf
+}
+
+// WithNumberingSystem sets the digits to use, either a CLDR numbering system name such as 'latn' or 'arab', or
+// NativeNumberingSystem; unknown numbering systems are ignored
+func (nf *NumberFormatter) WithNumberingSystem(numberingSystem string) *NumberFormatter {
+	if nf == nil {
+		return nf
+	}
+
+	if numberingSystem == NativeNumberingSystem {
+		numberingSystem = nf.symbols.nativeNumberingSystem
+	}
+	if _, exists := numberingSystems[numberingSystem]; exists {
+		nf.numberingSystem = numberingSystem
+	}
+	return nf
+}
+
+// Format returns the localized representation of the number; numbers may be any integer or float type, or a numeric
+// string
+func (nf *NumberFormatter) Format(number any) string {
+	if nf == nil {
+		return fmt.Sprint(number)
+	}
+
+	value, ok := messageNumber(number)
+	if !ok {
+		return fmt.Sprint(number)
+	}
+
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "∞"
+	case math.IsInf(value, -1):
+		return nf.symbols.minus + "∞"
+	}
+
+	switch nf.style {
+	case NumberPercent:
+		return nf.formatPattern(value*100, nf.symbols.percentPattern, 0, 0)
+	case NumberScientific:
+		return nf.formatScientific(value)
+	case NumberCurrency, NumberAccounting:
+		return nf.formatCurrency(value)
+	case NumberCompact:
+		return nf.formatCompact(value)
+	case NumberByteSize:
+		return nf.formatByteSize(value, 1000, decimalByteUnits)
+	case NumberBinaryByteSize:
+		return nf.formatByteSize(value, 1024, binaryByteUnits)
+	default:
+		return nf.formatPattern(value, nf.symbols.decimalPattern, nf.symbols.decimalPattern.minimumFraction, nf.symbols.decimalPattern.maximumFraction)
+	}
+}
+
+func (nf *NumberFormatter) fractionDigits(minimum int, maximum int) (int, int) {
+	if nf.fractionSet {
+		return nf.minimumFraction, nf.maximumFraction
+	}
+	return minimum, maximum
+}
+
+func (nf *NumberFormatter) formatPattern(value float64, pattern numberPattern, minimumFraction int, maximumFraction int) string {
+	minimumFraction, maximumFraction = nf.fractionDigits(minimumFraction, maximumFraction)
+
+	number, zero := nf.formatDecimal(math.Abs(value), pattern, minimumFraction, maximumFraction)
+	prefix, suffix := pattern.affixes(nf.symbols, value < 0 && !zero, nf.currencySymbol())
+
+	return prefix + number + suffix
+}
+
+func (nf *NumberFormatter) formatScientific(value float64) string {
+	minimumFraction, maximumFraction := nf.fractionDigits(0, 3)
+
+	abs := math.Abs(value)
+	exponent := 0
+	if abs != 0 {
+		exponent = int(math.Floor(math.Log10(abs)))
+	}
+
+	mantissa := abs / math.Pow10(exponent)
+	if rounded, _ := strconv.ParseFloat(strconv.FormatFloat(mantissa, 'f', maximumFraction, 64), 64); rounded >= 10 {
+		mantissa /= 10
+		exponent++
+	}
+
+	number, zero := nf.formatDecimal(mantissa, numberPattern{}, minimumFraction, maximumFraction)
+
+	exponentText := nf.transliterate(strconv.Itoa(exponent))
+	if exponent < 0 {
+		exponentText = nf.symbols.minus + nf.transliterate(strconv.Itoa(-exponent))
+	}
+
+	if value < 0 && !zero {
+		return nf.symbols.minus + number + "E" + exponentText
+	}
+	return number + "E" + exponentText
+}
+
+// formatDecimal formats the absolute value with the fraction digits and the grouping of the pattern, also reporting
+// whether the value was rounded to zero
+func (nf *NumberFormatter) formatDecimal(abs float64, pattern numberPattern, minimumFraction int, maximumFraction int) (string, bool) {
+	text := strconv.FormatFloat(abs, 'f', maximumFraction, 64)
+	zero := strings.Trim(text, "0.") == ""
+
+	integer, fraction, _ := strings.Cut(text, ".")
+	for len(fraction) > minimumFraction && strings.HasSuffix(fraction, "0") {
+		fraction = fraction[:len(fraction)-1]
+	}
+
+	minimumInteger := max(nf.minimumInteger, pattern.minimumInteger)
+	if len(integer) < minimumInteger {
+		integer = strings.Repeat("0", minimumInteger-len(integer)) + integer
+	}
+
+	if nf.grouping && pattern.primaryGroup > 0 && len(integer) >= pattern.primaryGroup+nf.symbols.minimumGroupingDigits {
+		integer = groupDigits(integer, pattern.primaryGroup, pattern.secondaryGroup, nf.symbols.group)
+	}
+
+	if fraction != "" {
+		return nf.transliterate(integer) + nf.symbols.decimal + nf.transliterate(fraction), zero
+	}
+	return nf.transliterate(integer), zero
+}
+
+func groupDigits(integer string, primary int, secondary int, separator string) string {
+	if len(integer) <= primary {
+		return integer
+	}
+
+	groups := []string{integer[len(integer)-primary:]}
+	integer = integer[:len(integer)-primary]
+	for len(integer) > secondary {
+		groups = append([]string{integer[len(integer)-secondary:]}, groups...)
+		integer = integer[:len(integer)-secondary]
+	}
+	groups = append([]string{integer}, groups...)
+
+	return strings.Join(groups, separator)
+}
+
+// transliterate replaces the ASCII digits of the text with the digits of the numbering system
+func (nf *NumberFormatter) transliterate(text string) string {
+	digits, exists := numberingSystems[nf.numberingSystem]
+	if nf.numberingSystem == "latn" || !exists {
+		return text
+	}
+
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return digits[r-'0']
+		}
+		return r
+	}, text)
+}
@@ -0,0 +1,64 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestNumberFormatter(t *testing.T) {
+	tests := []struct {
+		formatter *i18n.NumberFormatter
+		number    any
+		expected  string
+	}{
+		{i18n.NewNumberFormatter("en-US"), 1234567.5, "1,234,567.5"},
+		{i18n.NewNumberFormatter("de-DE"), 1234567.5, "1.234.567,5"},
+		{i18n.NewNumberFormatter("fr-FR"), 1234567.5, "1 234 567,5"},
+		{i18n.NewNumberFormatter("de-CH"), 1234567.5, "1’234’567.5"},
+		{i18n.NewNumberFormatter("en-IN"), 12345678, "1,23,45,678"},
+		{i18n.NewNumberFormatter("es"), 1234, "1234"},
+		{i18n.NewNumberFormatter("es"), 12345, "12.345"},
+		{i18n.NewNumberFormatter("en"), -1234.5678, "-1,234.568"},
+		{i18n.NewNumberFormatter("en"), -0.0001, "0"},
+		{i18n.NewNumberFormatter("sv"), -5, "−5"},
+		{i18n.NewNumberFormatter("en").WithFractionDigits(2, 2), 3, "3.00"},
+		{i18n.NewNumberFormatter("en").WithFractionDigits(0, 1), 2.25, "2.2"},
+		{i18n.NewNumberFormatter("en").WithGrouping(false), 12345, "12345"},
+		{i18n.NewNumberFormatter("en").WithMinimumIntegerDigits(3), 7, "007"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberPercent), 0.256, "26%"},
+		{i18n.NewNumberFormatter("de").WithStyle(i18n.NumberPercent), 0.5, "50 %"},
+		{i18n.NewNumberFormatter("tr").WithStyle(i18n.NumberPercent), 0.5, "%50"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberScientific), 1234567, "1.235E6"},
+		{i18n.NewNumberFormatter("en").WithStyle(i18n.NumberScientific), 0.00012, "1.2E-4"},
+		{i18n.NewNumberFormatter("ar"), 1234.5, "١٬٢٣٤٫٥"},
+		{i18n.NewNumberFormatter("th").WithNumberingSystem(i18n.NativeNumberingSystem), 123, "๑๒๓"},
+		{i18n.NewNumberFormatter("ar").WithNumberingSystem("latn"), 12, "12"},
+		{i18n.NewNumberFormatter("unknown"), 1234.5, "1,234.5"},
+	}
+
+	for _, test := range tests {
+		if v := test.formatter.Format(test.number); v != test.expected {
+			t.Errorf("expected '%s' for %v but got '%s'", test.expected, test.number, v)
+		}
+	}
+}
+
+func TestReaderFormatNumber(t *testing.T) {
+	catalog := i18n.NewCatalog()
+	catalog.AddKeyValue("de", i18n.NewKeyPair("files", "{count, plural, one {# Datei} other {# Dateien}}"))
+
+	catalogReader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("de")
+
+	if v := catalogReader.FormatNumber(1234.5); v != "1.234,5" {
+		t.Errorf("expected '1.234,5' but got '%s'", v)
+	}
+
+	v, err := catalogReader.FormatMessage("files", i18n.Args{"count": 1500})
+	switch {
+	case err != nil:
+		t.Errorf("failed to format message; %v", err)
+	case v != "1.500 Dateien":
+		t.Errorf("expected '1.500 Dateien' but got '%s'", v)
+	}
+}
@@ -10,3 +10,9 @@ type KeyValue interface {
 	Key() string
 	Value() string
 }
+
+// KeyValueValidator is a function used by the Catalog to validate keyValue data sets when initializing
+type KeyValueValidator func(locale string, keyValue KeyValue) error
+
+// LocaleValidator is a function used by the Catalog to validate all of the keyValues of a locale when initializing
+type LocaleValidator func(locale string, keyValues map[string]KeyValue) error
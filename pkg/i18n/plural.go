@@ -0,0 +1,378 @@
+package i18n
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// PluralCategory is a CLDR plural category
+type PluralCategory string
+
+const (
+	PluralZero  = PluralCategory("zero")
+	PluralOne   = PluralCategory("one")
+	PluralTwo   = PluralCategory("two")
+	PluralFew   = PluralCategory("few")
+	PluralMany  = PluralCategory("many")
+	PluralOther = PluralCategory("other")
+)
+
+var pluralCategoryOrder = []PluralCategory{PluralZero, PluralOne, PluralTwo, PluralFew, PluralMany, PluralOther}
+
+var ErrInvalidPluralOperand = errors.New("invalid plural operand")
+
+// PluralOperands holds the CLDR plural operands of a number
+type PluralOperands struct {
+	N float64 // absolute value
+	I int64   // integer digits
+	V int     // number of visible fraction digits, with trailing zeros
+	W int     // number of visible fraction digits, without trailing zeros
+	F int64   // visible fraction digits, with trailing zeros
+	T int64   // visible fraction digits, without trailing zeros
+	E int     // compact decimal exponent
+}
+
+// NewPluralOperands returns the plural operands for the specified number; strings are used as-is so that visible
+// fraction digits ('1.50') and compact exponents ('1.2c6') are preserved
+func NewPluralOperands(number any) (PluralOperands, error) {
+	switch v := number.(type) {
+	case string:
+		return pluralOperandsFromString(v)
+	case float32:
+		return pluralOperandsFromString(strconv.FormatFloat(float64(v), 'f', -1, 32))
+	case float64:
+		return pluralOperandsFromString(strconv.FormatFloat(v, 'f', -1, 64))
+	}
+
+	if f, ok := messageNumber(number); ok {
+		return pluralOperandsFromString(strconv.FormatFloat(f, 'f', -1, 64))
+	}
+
+	return PluralOperands{}, fmt.Errorf("%w: %v", ErrInvalidPluralOperand, number)
+}
+
+func pluralOperandsFromString(s string) (PluralOperands, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "-")
+
+	ops := PluralOperands{}
+	if index := strings.IndexAny(s, "ce"); index >= 0 {
+		exponent, err := strconv.Atoi(s[index+1:])
+		if err != nil {
+			return PluralOperands{}, fmt.Errorf("%w: %s", ErrInvalidPluralOperand, s)
+		}
+		ops.E = exponent
+		s = shiftDecimal(s[:index], exponent)
+	}
+
+	integer, fraction, _ := strings.Cut(s, ".")
+	if integer == "" {
+		integer = "0"
+	}
+
+	i, err := strconv.ParseInt(integer, 10, 64)
+	if err != nil {
+		return PluralOperands{}, fmt.Errorf("%w: %s", ErrInvalidPluralOperand, s)
+	}
+	ops.I = i
+
+	if fraction != "" {
+		f, err := strconv.ParseInt(fraction, 10, 64)
+		if err != nil {
+			return PluralOperands{}, fmt.Errorf("%w: %s", ErrInvalidPluralOperand, s)
+		}
+		ops.V, ops.F = len(fraction), f
+
+		trimmed := strings.TrimRight(fraction, "0")
+		ops.W = len(trimmed)
+		if trimmed != "" {
+			ops.T, _ = strconv.ParseInt(trimmed, 10, 64)
+		}
+	}
+
+	ops.N, err = strconv.ParseFloat(integer+"."+fraction+"0", 64)
+	if err != nil {
+		return PluralOperands{}, fmt.Errorf("%w: %s", ErrInvalidPluralOperand, s)
+	}
+
+	return ops, nil
+}
+
+// shiftDecimal moves the decimal point of the decimal string right by the specified number of places
+func shiftDecimal(s string, places int) string {
+	integer, fraction, _ := strings.Cut(s, ".")
+	for ; places > 0; places-- {
+		if fraction == "" {
+			integer += "0"
+			continue
+		}
+		integer, fraction = integer+fraction[:1], fraction[1:]
+	}
+
+	if fraction == "" {
+		return integer
+	}
+	return integer + "." + fraction
+}
+
+func (ops PluralOperands) value(operand byte) float64 {
+	switch operand {
+	case 'n':
+		return ops.N
+	case 'i':
+		return float64(ops.I)
+	case 'v':
+		return float64(ops.V)
+	case 'w':
+		return float64(ops.W)
+	case 'f':
+		return float64(ops.F)
+	case 't':
+		return float64(ops.T)
+	default:
+		return float64(ops.E)
+	}
+}
+
+// PluralRules selects the plural category of a number for a locale
+type PluralRules struct {
+	rules []pluralRule
+}
+
+type pluralRule struct {
+	category  PluralCategory
+	condition [][]pluralRelation // or-ed and-conditions
+}
+
+type pluralRelation struct {
+	operand byte
+	modulo  float64
+	negate  bool
+	ranges  [][2]float64
+}
+
+// Select returns the plural category for the specified number, or PluralOther if it is not a valid number
+func (pr *PluralRules) Select(number any) PluralCategory {
+	ops, err := NewPluralOperands(number)
+	if err != nil {
+		return PluralOther
+	}
+	return pr.SelectOperands(ops)
+}
+
+// SelectOperands returns the plural category for the specified plural operands
+func (pr *PluralRules) SelectOperands(ops PluralOperands) PluralCategory {
+	if pr == nil {
+		return PluralOther
+	}
+
+	for _, rule := range pr.rules {
+		if rule.matches(ops) {
+			return rule.category
+		}
+	}
+	return PluralOther
+}
+
+// Categories returns the plural categories used by the rules, always including PluralOther
+func (pr *PluralRules) Categories() []PluralCategory {
+	used := map[PluralCategory]bool{PluralOther: true}
+	if pr != nil {
+		for _, rule := range pr.rules {
+			used[rule.category] = true
+		}
+	}
+
+	categories := make([]PluralCategory, 0, len(used))
+	for _, category := range pluralCategoryOrder {
+		if used[category] {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+func (rule pluralRule) matches(ops PluralOperands) bool {
+	for _, and := range rule.condition {
+		matched := true
+		for _, relation := range and {
+			if !relation.matches(ops) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (relation pluralRelation) matches(ops PluralOperands) bool {
+	value := ops.value(relation.operand)
+	if relation.modulo > 0 {
+		value = math.Mod(value, relation.modulo)
+	}
+
+	inRange := false
+	if value == math.Trunc(value) {
+		for _, r := range relation.ranges {
+			if value >= r[0] && value <= r[1] {
+				inRange = true
+				break
+			}
+		}
+	}
+
+	return inRange != relation.negate
+}
+
+// parsePluralRules parses rules of the form 'one: i = 1 and v = 0; few: ...'
+func parsePluralRules(text string) (*PluralRules, error) {
+	pr := &PluralRules{}
+	for _, definition := range strings.Split(text, ";") {
+		definition = strings.TrimSpace(definition)
+		if definition == "" {
+			continue
+		}
+
+		category, condition, found := strings.Cut(definition, ":")
+		if !found || !isPluralCategory(strings.TrimSpace(category)) {
+			return nil, fmt.Errorf("invalid plural rule '%s'", definition)
+		}
+
+		rule := pluralRule{category: PluralCategory(strings.TrimSpace(category))}
+		for _, orCondition := range strings.Split(condition, " or ") {
+			and := make([]pluralRelation, 0)
+			for _, relationText := range strings.Split(orCondition, " and ") {
+				relation, err := parsePluralRelation(strings.TrimSpace(relationText))
+				if err != nil {
+					return nil, fmt.Errorf("invalid plural rule '%s': %w", definition, err)
+				}
+				and = append(and, relation)
+			}
+			rule.condition = append(rule.condition, and)
+		}
+
+		pr.rules = append(pr.rules, rule)
+	}
+
+	return pr, nil
+}
+
+func parsePluralRelation(text string) (pluralRelation, error) {
+	relation := pluralRelation{}
+
+	expression, rangeList, found := strings.Cut(text, "!=")
+	if found {
+		relation.negate = true
+	} else if expression, rangeList, found = strings.Cut(text, "="); !found {
+		return relation, fmt.Errorf("missing operator in '%s'", text)
+	}
+
+	expression = strings.TrimSpace(expression)
+	operand, modulo, hasModulo := strings.Cut(expression, "%")
+	operand = strings.TrimSpace(operand)
+	if len(operand) != 1 || !strings.Contains("niwvfte", operand) {
+		return relation, fmt.Errorf("invalid operand '%s'", operand)
+	}
+	relation.operand = operand[0]
+
+	if hasModulo {
+		m, err := strconv.ParseFloat(strings.TrimSpace(modulo), 64)
+		if err != nil || m <= 0 {
+			return relation, fmt.Errorf("invalid modulo '%s'", modulo)
+		}
+		relation.modulo = m
+	}
+
+	for _, r := range strings.Split(rangeList, ",") {
+		low, high, isRange := strings.Cut(strings.TrimSpace(r), "..")
+		if !isRange {
+			high = low
+		}
+
+		lowValue, err := strconv.ParseFloat(low, 64)
+		if err != nil {
+			return relation, fmt.Errorf("invalid range '%s'", r)
+		}
+		highValue, err := strconv.ParseFloat(high, 64)
+		if err != nil {
+			return relation, fmt.Errorf("invalid range '%s'", r)
+		}
+		relation.ranges = append(relation.ranges, [2]float64{lowValue, highValue})
+	}
+
+	return relation, nil
+}
+
+//go:embed data/plurals.txt
+var pluralsData string
+
+var (
+	pluralRulesOnce sync.Once
+	pluralRulesData map[string]map[string]*PluralRules // type -> locale -> rules
+)
+
+func loadPluralRules() {
+	pluralRulesData = make(map[string]map[string]*PluralRules)
+
+	scanner := bufio.NewScanner(strings.NewReader(pluralsData))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		tokens := strings.SplitN(line, "|", 3)
+		if len(tokens) != 3 {
+			panic(fmt.Sprintf("i18n: invalid plural data line '%s'", line))
+		}
+
+		rules, err := parsePluralRules(tokens[2])
+		if err != nil {
+			panic(fmt.Sprintf("i18n: invalid plural data: %v", err))
+		}
+
+		ruleType := strings.TrimSpace(tokens[0])
+		if pluralRulesData[ruleType] == nil {
+			pluralRulesData[ruleType] = make(map[string]*PluralRules)
+		}
+		for _, locale := range strings.Fields(tokens[1]) {
+			pluralRulesData[ruleType][locale] = rules
+		}
+	}
+}
+
+func pluralRulesFor(ruleType string, locale string) *PluralRules {
+	pluralRulesOnce.Do(loadPluralRules)
+
+	for _, candidate := range localeCandidates(locale) {
+		if rules, exists := pluralRulesData[ruleType][candidate]; exists {
+			return rules
+		}
+	}
+	return pluralRulesData[ruleType]["root"]
+}
+
+// CardinalRules returns the CLDR cardinal plural rules for the specified locale, falling back to the root rules
+// (everything is PluralOther) for unknown locales
+func CardinalRules(locale string) *PluralRules {
+	return pluralRulesFor("cardinal", locale)
+}
+
+// OrdinalRules returns the CLDR ordinal plural rules for the specified locale, falling back to the root rules
+// (everything is PluralOther) for unknown locales
+func OrdinalRules(locale string) *PluralRules {
+	return pluralRulesFor("ordinal", locale)
+}
+
+// PluralKey returns the catalog key used for the plural variant of the specified key, e.g. 'files[one]'
+func PluralKey(key string, category PluralCategory) string {
+	return VariantKey(key, string(category))
+}
@@ -0,0 +1,81 @@
+package i18n
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type pluralRange struct {
+	start PluralCategory
+	end   PluralCategory
+}
+
+//go:embed data/plural_ranges.txt
+var pluralRangesData string
+
+var (
+	pluralRangesOnce sync.Once
+	pluralRanges     map[string]map[pluralRange]PluralCategory
+)
+
+func loadPluralRanges() {
+	pluralRanges = make(map[string]map[pluralRange]PluralCategory)
+
+	scanner := bufio.NewScanner(strings.NewReader(pluralRangesData))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		locales, definitions, found := strings.Cut(line, "|")
+		if !found {
+			panic(fmt.Sprintf("i18n: invalid plural range data line '%s'", line))
+		}
+
+		ranges := make(map[pluralRange]PluralCategory)
+		for _, definition := range strings.Split(definitions, ";") {
+			categories, result, found := strings.Cut(definition, ":")
+			start, end, isRange := strings.Cut(categories, "+")
+			if !found || !isRange {
+				panic(fmt.Sprintf("i18n: invalid plural range '%s'", definition))
+			}
+
+			r := pluralRange{start: PluralCategory(strings.TrimSpace(start)), end: PluralCategory(strings.TrimSpace(end))}
+			ranges[r] = PluralCategory(strings.TrimSpace(result))
+		}
+
+		for _, locale := range strings.Fields(locales) {
+			pluralRanges[locale] = ranges
+		}
+	}
+}
+
+// PluralRangeCategory returns the CLDR plural category for a range whose start and end have the specified categories
+func PluralRangeCategory(locale string, start PluralCategory, end PluralCategory) PluralCategory {
+	pluralRangesOnce.Do(loadPluralRanges)
+
+	for _, candidate := range localeCandidates(locale) {
+		ranges, exists := pluralRanges[candidate]
+		if !exists {
+			continue
+		}
+
+		if result, exists := ranges[pluralRange{start: start, end: end}]; exists {
+			return result
+		}
+		break
+	}
+
+	return end
+}
+
+// SelectRange returns the plural category for the range of numbers from start to end using the cardinal rules of the
+// specified locale
+func SelectRange(locale string, start any, end any) PluralCategory {
+	rules := CardinalRules(locale)
+	return PluralRangeCategory(locale, rules.Select(start), rules.Select(end))
+}
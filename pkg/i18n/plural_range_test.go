@@ -0,0 +1,80 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestOrdinalRules(t *testing.T) {
+	tests := []struct {
+		locale   string
+		number   any
+		expected i18n.PluralCategory
+	}{
+		{"en", 1, i18n.PluralOne},
+		{"en", 22, i18n.PluralTwo},
+		{"en", 103, i18n.PluralFew},
+		{"en", 11, i18n.PluralOther},
+		{"en", 13, i18n.PluralOther},
+		{"fr", 1, i18n.PluralOne},
+		{"fr", 3, i18n.PluralOther},
+		{"es", 2, i18n.PluralOther},
+		{"it", 8, i18n.PluralMany},
+		{"cy", 7, i18n.PluralZero},
+		{"sv", 12, i18n.PluralOther},
+		{"sv", 32, i18n.PluralOne},
+	}
+
+	for _, test := range tests {
+		if category := i18n.OrdinalRules(test.locale).Select(test.number); category != test.expected {
+			t.Errorf("expected '%s' for %v in '%s' but got '%s'", test.expected, test.number, test.locale, category)
+		}
+	}
+}
+
+func TestPluralRanges(t *testing.T) {
+	tests := []struct {
+		locale     string
+		start, end any
+		expected   i18n.PluralCategory
+	}{
+		{"en", 1, 3, i18n.PluralOther},
+		{"en", 0, 1, i18n.PluralOther},
+		{"sv", 0, 1, i18n.PluralOther},
+		{"ka", 1, 2, i18n.PluralOne},
+		{"ka", 0, 1, i18n.PluralOther},
+		{"ro", 2, 1, i18n.PluralFew},
+		{"sl", 5, 1, i18n.PluralFew},
+		{"ru", 1, 2, i18n.PluralFew},
+		{"ar", 0, 1, i18n.PluralZero},
+	}
+
+	for _, test := range tests {
+		if category := i18n.SelectRange(test.locale, test.start, test.end); category != test.expected {
+			t.Errorf("expected '%s' for %v-%v in '%s' but got '%s'", test.expected, test.start, test.end, test.locale, category)
+		}
+	}
+}
+
+func TestReaderOrdinalAndRange(t *testing.T) {
+	catalog := i18n.NewCatalog()
+	catalog.AddKeyValue("en", i18n.NewKeyPair("place[one]", "st"))
+	catalog.AddKeyValue("en", i18n.NewKeyPair("place[two]", "nd"))
+	catalog.AddKeyValue("en", i18n.NewKeyPair("place[few]", "rd"))
+	catalog.AddKeyValue("en", i18n.NewKeyPair("place[other]", "th"))
+	catalog.AddKeyValue("en", i18n.NewKeyPair("days[one]", "day"))
+	catalog.AddKeyValue("en", i18n.NewKeyPair("days[other]", "days"))
+
+	catalogReader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("en")
+
+	for position, expected := range map[int]string{1: "st", 22: "nd", 23: "rd", 11: "th", 4: "th"} {
+		if v := catalogReader.Ordinal("place", position); v.Value() != expected {
+			t.Errorf("expected '%s' for %d but got '%s'", expected, position, v.Value())
+		}
+	}
+
+	if v := catalogReader.PluralRange("days", 1, 3); v.Value() != "days" {
+		t.Errorf("expected 'days' for range 1-3 but got '%s'", v.Value())
+	}
+}
@@ -0,0 +1,110 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestCardinalRules(t *testing.T) {
+	tests := []struct {
+		locale   string
+		number   any
+		expected i18n.PluralCategory
+	}{
+		{"en", 1, i18n.PluralOne},
+		{"en-US", 2, i18n.PluralOther},
+		{"en", "1.0", i18n.PluralOther},
+		{"fr", 0, i18n.PluralOne},
+		{"fr", 1.5, i18n.PluralOne},
+		{"fr", 1000000, i18n.PluralMany},
+		{"fr", "1.2c6", i18n.PluralMany},
+		{"pt_PT", 0, i18n.PluralOther},
+		{"pt-BR", 0, i18n.PluralOne},
+		{"ru", 1, i18n.PluralOne},
+		{"ru", 21, i18n.PluralOne},
+		{"ru", 3, i18n.PluralFew},
+		{"ru", 11, i18n.PluralMany},
+		{"ru", 1.5, i18n.PluralOther},
+		{"pl", 22, i18n.PluralFew},
+		{"pl", 25, i18n.PluralMany},
+		{"ar", 0, i18n.PluralZero},
+		{"ar", 2, i18n.PluralTwo},
+		{"ar", 105, i18n.PluralFew},
+		{"ar", 111, i18n.PluralMany},
+		{"he", 2, i18n.PluralTwo},
+		{"iw", 2, i18n.PluralTwo},
+		{"iw-IL", 1, i18n.PluralOne},
+		{"cy", 6, i18n.PluralMany},
+		{"ja", 1, i18n.PluralOther},
+		{"unknown", 1, i18n.PluralOther},
+		{"lv", "0.1", i18n.PluralOne},
+		{"en", -1, i18n.PluralOne},
+	}
+
+	for _, test := range tests {
+		if category := i18n.CardinalRules(test.locale).Select(test.number); category != test.expected {
+			t.Errorf("expected '%s' for %v in '%s' but got '%s'", test.expected, test.number, test.locale, category)
+		}
+	}
+}
+
+func TestPluralCategories(t *testing.T) {
+	categories := i18n.CardinalRules("ar").Categories()
+	if len(categories) != 6 || categories[0] != i18n.PluralZero || categories[5] != i18n.PluralOther {
+		t.Errorf("unexpected categories for 'ar': %v", categories)
+	}
+
+	categories = i18n.CardinalRules("ja").Categories()
+	if len(categories) != 1 || categories[0] != i18n.PluralOther {
+		t.Errorf("unexpected categories for 'ja': %v", categories)
+	}
+}
+
+func TestPluralOperands(t *testing.T) {
+	ops, err := i18n.NewPluralOperands("-1.250")
+	switch {
+	case err != nil:
+		t.Fatalf("unexpected error creating plural operands; %v", err)
+	case ops.N != 1.25 || ops.I != 1 || ops.V != 3 || ops.W != 2 || ops.F != 250 || ops.T != 25:
+		t.Errorf("unexpected plural operands %+v", ops)
+	}
+
+	if _, err := i18n.NewPluralOperands("abc"); err == nil {
+		t.Error("expected error for invalid plural operand")
+	}
+}
+
+func TestReaderPlural(t *testing.T) {
+	catalog := i18n.NewCatalog().WithDefaultLocale("en")
+	catalog.AddKeyValue("en", i18n.NewKeyPair("files[one]", "one file"))
+	catalog.AddKeyValue("en", i18n.NewKeyPair("files[other]", "many files"))
+	catalog.AddKeyValue("ru", i18n.NewKeyPair("files[one]", "один файл"))
+	catalog.AddKeyValue("ru", i18n.NewKeyPair("files[few]", "файла"))
+	catalog.AddKeyValue("ru", i18n.NewKeyPair("files[other]", "файлов"))
+
+	tests := []struct {
+		locale   string
+		count    any
+		expected string
+	}{
+		{"en", 1, "one file"},
+		{"en", 7, "many files"},
+		{"ru", 21, "один файл"},
+		{"ru", 3, "файла"},
+		{"ru", 5, "файлов"},
+		{"de", 1, "one file"},
+	}
+
+	for _, test := range tests {
+		v := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale(test.locale).Plural("files", test.count)
+		if v.Value() != test.expected {
+			t.Errorf("expected '%s' for %v in '%s' but got '%s'", test.expected, test.count, test.locale, v.Value())
+		}
+	}
+
+	v := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("en").Plural("invalid", 1)
+	if v.Key() != "invalid" {
+		t.Errorf("expected unknown key 'invalid' but got '%s'", v.Key())
+	}
+}
@@ -0,0 +1,174 @@
+package i18n
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+const (
+	ProblemContentType = "application/problem+json"
+	ProblemBlankType   = "about:blank"
+)
+
+// Problem describes an RFC 9457 problem whose title and detail are catalog keys, rendered as a ProblemDocument in
+// the locale of the caller while its type URI does not depend on the locale
+type Problem struct {
+	typeURI    string
+	status     int
+	titleKey   string
+	detail     *LocalizedError
+	instance   string
+	extensions map[string]any
+	catalog    *Catalog
+}
+
+// ProblemDocument is the RFC 9457 problem details document of a Problem, in the locale of a catalog reader
+type ProblemDocument struct {
+	Type       string
+	Title      string
+	Status     int
+	Detail     string
+	Instance   string
+	Extensions map[string]any
+}
+
+// NewProblem returns a new Problem for the type URI, e.g. 'https://example.com/problems/out-of-credit', the HTTP
+// status and the catalog key of its title; an empty type URI is 'about:blank' and an empty title key uses the status
+// text
+func NewProblem(typeURI string, status int, titleKey string) *Problem {
+	if typeURI == "" {
+		typeURI = ProblemBlankType
+	}
+	return &Problem{
+		typeURI:    typeURI,
+		status:     status,
+		titleKey:   titleKey,
+		extensions: make(map[string]any),
+	}
+}
+
+// WithDetail sets the catalog key and arguments of the detail of the problem
+func (p *Problem) WithDetail(key string, args ...any) *Problem {
+	if p != nil {
+		p.detail = NewLocalizedError(key, args...)
+	}
+	return p
+}
+
+// WithError sets the detail of the problem to the first LocalizedError of the error chain; other errors are not part
+// of the problem, to avoid exposing internal messages
+func (p *Problem) WithError(err error) *Problem {
+	var le *LocalizedError
+	if p != nil && errors.As(err, &le) {
+		p.detail = le
+	}
+	return p
+}
+
+// WithInstance sets the URI of the occurrence of the problem
+func (p *Problem) WithInstance(instance string) *Problem {
+	if p != nil {
+		p.instance = instance
+	}
+	return p
+}
+
+// WithExtension adds an extension member to the problem, e.g. 'balance'; extension members do not replace the
+// standard members
+func (p *Problem) WithExtension(name string, value any) *Problem {
+	if p != nil {
+		p.extensions[name] = value
+	}
+	return p
+}
+
+// WithCatalog sets the catalog whose default locale renders the problem when there is no catalog reader
+func (p *Problem) WithCatalog(catalog *Catalog) *Problem {
+	if p != nil {
+		p.catalog = catalog
+	}
+	return p
+}
+
+// Status returns the HTTP status of the problem
+func (p *Problem) Status() int {
+	if p == nil {
+		return http.StatusInternalServerError
+	}
+	return p.status
+}
+
+// Document returns the problem document with its title and detail in the locale of the catalog reader, or in the
+// default locale of the problem catalog when the reader is nil
+func (p *Problem) Document(reader *CatalogReader) ProblemDocument {
+	if p == nil {
+		return ProblemDocument{Type: ProblemBlankType, Title: http.StatusText(http.StatusInternalServerError), Status: http.StatusInternalServerError}
+	}
+	if reader == nil {
+		reader = defaultCatalogReader(p.catalog)
+	}
+
+	document := ProblemDocument{
+		Type:       p.typeURI,
+		Title:      http.StatusText(p.status),
+		Status:     p.status,
+		Detail:     p.detail.Localize(reader),
+		Instance:   p.instance,
+		Extensions: p.extensions,
+	}
+	if p.titleKey != "" {
+		document.Title, _ = reader.Format(p.titleKey)
+	}
+	return document
+}
+
+// ServeHTTP writes the problem document in the locale of the catalog reader of the request context, with the
+// problem status and the 'application/problem+json' content type
+func (p *Problem) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	WriteProblem(w, r, p)
+}
+
+// WriteProblem writes the problem document in the locale of the catalog reader of the request context
+func WriteProblem(w http.ResponseWriter, r *http.Request, problem *Problem) {
+	document := problem.Document(CatalogReaderFromContext(r.Context()))
+
+	data, err := json.Marshal(document)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(document.Status)
+	_, _ = w.Write(data)
+}
+
+// WriteErrorProblem writes the problem of the type URI and status for the error, with the detail of its first
+// LocalizedError, in the locale of the catalog reader of the request context
+func WriteErrorProblem(w http.ResponseWriter, r *http.Request, typeURI string, status int, titleKey string, err error) {
+	WriteProblem(w, r, NewProblem(typeURI, status, titleKey).WithError(err))
+}
+
+// MarshalJSON returns the JSON object of the document with its non-empty standard members and its extension members
+func (pd ProblemDocument) MarshalJSON() ([]byte, error) {
+	members := make(map[string]any, len(pd.Extensions)+5)
+	for name, value := range pd.Extensions {
+		members[name] = value
+	}
+
+	standard := map[string]any{"type": pd.Type, "title": pd.Title, "detail": pd.Detail, "instance": pd.Instance}
+	for name, value := range standard {
+		if value != "" {
+			members[name] = value
+		} else {
+			delete(members, name)
+		}
+	}
+	delete(members, "status")
+	if pd.Status != 0 {
+		members["status"] = pd.Status
+	}
+
+	return json.Marshal(members)
+}
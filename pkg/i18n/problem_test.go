@@ -0,0 +1,78 @@
+package i18n_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestProblemServeHTTP(t *testing.T) {
+	catalog := i18n.NewCatalog().WithDefaultLocale("en")
+	catalog.AddKeyValue("en", i18n.NewKeyPair("problem.credit.title", "You do not have enough credit"))
+	catalog.AddKeyValue("en", i18n.NewKeyPair("problem.credit.detail", "Your balance is {0}, but that costs {1}"))
+	catalog.AddKeyValue("fr", i18n.NewKeyPair("problem.credit.title", "Vous n'avez pas assez de crédit"))
+	catalog.AddKeyValue("fr", i18n.NewKeyPair("problem.credit.detail", "Votre solde est de {0}, mais cela coûte {1}"))
+
+	problem := i18n.NewProblem("https://example.com/problems/out-of-credit", http.StatusForbidden, "problem.credit.title").
+		WithDetail("problem.credit.detail", 30, 50).WithInstance("/account/12345/msgs/abc").WithExtension("balance", 30).
+		WithExtension("type", "ignored").WithCatalog(catalog)
+
+	tests := []struct {
+		locale   string
+		expected string
+	}{
+		{"fr", `{"balance":30,"detail":"Votre solde est de 30, mais cela coûte 50","instance":"/account/12345/msgs/abc",` +
+			`"status":403,"title":"Vous n'avez pas assez de crédit","type":"https://example.com/problems/out-of-credit"}`},
+		{"", `{"balance":30,"detail":"Your balance is 30, but that costs 50","instance":"/account/12345/msgs/abc",` +
+			`"status":403,"title":"You do not have enough credit","type":"https://example.com/problems/out-of-credit"}`},
+	}
+
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		if test.locale != "" {
+			_, ctx := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale(test.locale).WithContext(r.Context())
+			r = r.WithContext(ctx)
+		}
+
+		rec := httptest.NewRecorder()
+		problem.ServeHTTP(rec, r)
+
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("expected status %d but got %d", http.StatusForbidden, rec.Code)
+		}
+		if v := rec.Header().Get("Content-Type"); v != i18n.ProblemContentType {
+			t.Errorf("expected content type '%s' but got '%s'", i18n.ProblemContentType, v)
+		}
+		if v := rec.Body.String(); v != test.expected {
+			t.Errorf("expected '%s' for '%s' but got '%s'", test.expected, test.locale, v)
+		}
+	}
+}
+
+func TestWriteErrorProblem(t *testing.T) {
+	catalog := i18n.NewCatalog().WithDefaultLocale("en")
+	catalog.AddKeyValue("de", i18n.NewKeyPair("error.not_found", "{0} wurde nicht gefunden"))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	_, ctx := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("de").WithContext(r.Context())
+	r = r.WithContext(ctx)
+
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{fmt.Errorf("lookup: %w", i18n.NewLocalizedError("error.not_found", "Bericht")), `{"detail":"Bericht wurde nicht gefunden","status":404,"title":"Not Found","type":"about:blank"}`},
+		{fmt.Errorf("connection refused"), `{"status":404,"title":"Not Found","type":"about:blank"}`},
+	}
+
+	for _, test := range tests {
+		rec := httptest.NewRecorder()
+		i18n.WriteErrorProblem(rec, r, "", http.StatusNotFound, "", test.err)
+		if v := rec.Body.String(); v != test.expected {
+			t.Errorf("expected '%s' but got '%s'", test.expected, v)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package i18n
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TimeUnit is a CLDR calendar or duration unit such as 'day' or 'minute'
+type TimeUnit string
+
+const (
+	TimeUnitYear        TimeUnit = "year"
+	TimeUnitMonth       TimeUnit = "month"
+	TimeUnitWeek        TimeUnit = "week"
+	TimeUnitDay         TimeUnit = "day"
+	TimeUnitHour        TimeUnit = "hour"
+	TimeUnitMinute      TimeUnit = "minute"
+	TimeUnitSecond      TimeUnit = "second"
+	TimeUnitMillisecond TimeUnit = "millisecond"
+)
+
+// relativeTimeUnits lists the units selected automatically with their average length and the rounded value from
+// which the next unit is used
+var relativeTimeUnits = []struct {
+	unit     TimeUnit
+	duration time.Duration
+	limit    float64
+}{
+	{TimeUnitSecond, time.Second, 60},
+	{TimeUnitMinute, time.Minute, 60},
+	{TimeUnitHour, time.Hour, 24},
+	{TimeUnitDay, 24 * time.Hour, 7},
+	{TimeUnitWeek, 7 * 24 * time.Hour, 4},
+	{TimeUnitMonth, 2629746 * time.Second, 12},
+	{TimeUnitYear, 31556952 * time.Second, math.Inf(1)},
+}
+
+//go:embed data/relative_time.txt
+var relativeTimeData string
+
+type relativeTimePatterns struct {
+	past    map[PluralCategory]string
+	future  map[PluralCategory]string
+	phrases map[int]string
+}
+
+var (
+	relativeTimeOnce         sync.Once
+	relativeTimePatternsData map[string]*relativeTimePatterns // '<locale>/<width>/<unit>' -> patterns
+)
+
+func loadRelativeTimePatterns() {
+	relativeTimePatternsData = make(map[string]*relativeTimePatterns)
+
+	scanner := bufio.NewScanner(strings.NewReader(relativeTimeData))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := _numberDataRegex.FindAllString(line, -1)
+		if len(fields) < 5 {
+			panic(fmt.Sprintf("i18n: invalid relative time data line '%s'", line))
+		}
+
+		key := fields[0] + "/" + fields[1] + "/" + fields[2]
+		patterns, exists := relativeTimePatternsData[key]
+		if !exists {
+			patterns = &relativeTimePatterns{phrases: make(map[int]string)}
+			relativeTimePatternsData[key] = patterns
+		}
+
+		if offset, err := strconv.Atoi(fields[3]); err == nil {
+			phrase, err := strconv.Unquote(fields[4])
+			if err != nil {
+				panic(fmt.Sprintf("i18n: invalid relative time data field '%s': %v", fields[4], err))
+			}
+			patterns.phrases[offset] = phrase
+			continue
+		}
+
+		if len(fields)%2 != 0 {
+			panic(fmt.Sprintf("i18n: invalid relative time data line '%s'", line))
+		}
+
+		direction := make(map[PluralCategory]string)
+		for i := 4; i < len(fields); i += 2 {
+			pattern, err := strconv.Unquote(fields[i+1])
+			if err != nil {
+				panic(fmt.Sprintf("i18n: invalid relative time data field '%s': %v", fields[i+1], err))
+			}
+			direction[PluralCategory(fields[i])] = pattern
+		}
+
+		switch fields[3] {
+		case "past":
+			patterns.past = direction
+		case "future":
+			patterns.future = direction
+		default:
+			panic(fmt.Sprintf("i18n: invalid relative time data line '%s'", line))
+		}
+	}
+}
+
+// relativeTimeCandidates returns the patterns of the unit from the most to the least specific, trying the widths
+// of a locale before falling back to its parents and finally to the short 'root' patterns
+func relativeTimeCandidates(locale string, width Width, unit TimeUnit) []*relativeTimePatterns {
+	relativeTimeOnce.Do(loadRelativeTimePatterns)
+
+	widths := []Width{width}
+	switch width {
+	case WidthNarrow:
+		widths = append(widths, WidthShort, WidthLong)
+	case WidthShort:
+		widths = append(widths, WidthLong)
+	}
+
+	var candidates []*relativeTimePatterns
+	for _, candidate := range localeCandidates(locale) {
+		for _, w := range widths {
+			if patterns, exists := relativeTimePatternsData[candidate+"/"+w.String()+"/"+string(unit)]; exists {
+				candidates = append(candidates, patterns)
+			}
+		}
+	}
+	if patterns, exists := relativeTimePatternsData["root/short/"+string(unit)]; exists {
+		candidates = append(candidates, patterns)
+	}
+	return candidates
+}
+
+// RelativeTimeFormatter formats times relative to now, such as '3 minutes ago' or 'in 2 days', using the CLDR
+// relative time patterns and plural rules of a locale
+type RelativeTimeFormatter struct {
+	locale        string
+	numbers       *NumberFormatter
+	width         Width
+	alwaysNumeric bool
+	now           func() time.Time
+}
+
+// NewRelativeTimeFormatter returns a new long RelativeTimeFormatter for the specified locale
+func NewRelativeTimeFormatter(locale string) *RelativeTimeFormatter {
+	return &RelativeTimeFormatter{
+		locale:  locale,
+		numbers: NewNumberFormatter(locale),
+		width:   WidthLong,
+		now:     time.Now,
+	}
+}
+
+// WithWidth sets the width of the unit names, e.g. '3 minutes ago', '3 min. ago' or '3m ago' in english
+func (rf *RelativeTimeFormatter) WithWidth(width Width) *RelativeTimeFormatter {
+	if rf != nil {
+		rf.width = width
+	}
+	return rf
+}
+
+// WithAlwaysNumeric disables phrases such as 'yesterday' or 'next week' in favor of '1 day ago' or 'in 1 week'
+func (rf *RelativeTimeFormatter) WithAlwaysNumeric(alwaysNumeric bool) *RelativeTimeFormatter {
+	if rf != nil {
+		rf.alwaysNumeric = alwaysNumeric
+	}
+	return rf
+}
+
+// WithNow sets the function returning the current time used by FormatTime, time.Now by default
+func (rf *RelativeTimeFormatter) WithNow(now func() time.Time) *RelativeTimeFormatter {
+	if rf != nil && now != nil {
+		rf.now = now
+	}
+	return rf
+}
+
+// FormatTime returns the time relative to now, selecting the unit automatically
+func (rf *RelativeTimeFormatter) FormatTime(t time.Time) string {
+	if rf == nil {
+		return t.String()
+	}
+	return rf.FormatDuration(t.Sub(rf.now()))
+}
+
+// FormatDuration returns the offset relative to now, negative offsets being in the past, selecting the largest unit
+// for which the rounded value stays below the next unit, e.g. '2 hours ago' for -125 minutes
+func (rf *RelativeTimeFormatter) FormatDuration(offset time.Duration) string {
+	if rf == nil {
+		return offset.String()
+	}
+
+	abs := math.Abs(float64(offset))
+	for _, candidate := range relativeTimeUnits {
+		value := math.Round(abs / float64(candidate.duration))
+		if value < candidate.limit {
+			if offset < 0 {
+				value = -value
+			}
+			return rf.Format(value, candidate.unit)
+		}
+	}
+	return ""
+}
+
+// Format returns the value of the unit relative to now, negative values being in the past; unless always numeric,
+// small integer values use the phrases of the locale such as 'tomorrow'
+func (rf *RelativeTimeFormatter) Format(value any, unit TimeUnit) string {
+	number, ok := messageNumber(value)
+	if rf == nil || !ok {
+		return fmt.Sprint(value) + " " + string(unit)
+	}
+
+	candidates := relativeTimeCandidates(rf.locale, rf.width, unit)
+	if !rf.alwaysNumeric && number == math.Trunc(number) && math.Abs(number) <= 2 {
+		for _, patterns := range candidates {
+			if phrase, exists := patterns.phrases[int(number)]; exists {
+				return phrase
+			}
+		}
+	}
+
+	past := number < 0 || number == 0 && math.Signbit(number)
+	for _, patterns := range candidates {
+		direction := patterns.future
+		if past {
+			direction = patterns.past
+		}
+		if direction == nil {
+			continue
+		}
+
+		_, maximumFraction := rf.numbers.fractionDigits(rf.numbers.symbols.decimalPattern.minimumFraction, rf.numbers.symbols.decimalPattern.maximumFraction)
+		pattern, exists := direction[CardinalRules(rf.locale).Select(asciiDecimal(number, maximumFraction))]
+		if !exists {
+			pattern = direction[PluralOther]
+		}
+		return strings.ReplaceAll(pattern, "{0}", rf.numbers.Format(math.Abs(number)))
+	}
+
+	return fmt.Sprint(value) + " " + string(unit)
+}
@@ -0,0 +1,72 @@
+package i18n_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestRelativeTimeFormatter(t *testing.T) {
+	tests := []struct {
+		formatter *i18n.RelativeTimeFormatter
+		offset    time.Duration
+		expected  string
+	}{
+		{i18n.NewRelativeTimeFormatter("en"), -3 * time.Minute, "3 minutes ago"},
+		{i18n.NewRelativeTimeFormatter("en"), -time.Minute, "1 minute ago"},
+		{i18n.NewRelativeTimeFormatter("en"), 50 * time.Hour, "in 2 days"},
+		{i18n.NewRelativeTimeFormatter("en"), -24 * time.Hour, "yesterday"},
+		{i18n.NewRelativeTimeFormatter("en"), 0, "now"},
+		{i18n.NewRelativeTimeFormatter("en"), -125 * time.Minute, "2 hours ago"},
+		{i18n.NewRelativeTimeFormatter("en"), 59*time.Minute + 40*time.Second, "in 1 hour"},
+		{i18n.NewRelativeTimeFormatter("en"), -60 * 24 * time.Hour, "2 months ago"},
+		{i18n.NewRelativeTimeFormatter("en").WithAlwaysNumeric(true), -24 * time.Hour, "1 day ago"},
+		{i18n.NewRelativeTimeFormatter("en").WithWidth(i18n.WidthShort), -3 * time.Hour, "3 hr. ago"},
+		{i18n.NewRelativeTimeFormatter("en").WithWidth(i18n.WidthNarrow), 3 * time.Hour, "in 3h"},
+		{i18n.NewRelativeTimeFormatter("fr"), -3 * time.Hour, "il y a 3 heures"},
+		{i18n.NewRelativeTimeFormatter("fr-CA"), -24 * time.Hour, "hier"},
+		{i18n.NewRelativeTimeFormatter("de"), 3 * 7 * 24 * time.Hour, "in 3 Wochen"},
+		{i18n.NewRelativeTimeFormatter("ru"), -5 * time.Hour, "5 часов назад"},
+		{i18n.NewRelativeTimeFormatter("ru"), -22 * time.Minute, "22 минуты назад"},
+		{i18n.NewRelativeTimeFormatter("ja").WithWidth(i18n.WidthShort), 3 * time.Hour, "3 時間後"},
+		{i18n.NewRelativeTimeFormatter("unknown"), -3 * time.Hour, "-3 h"},
+	}
+
+	for _, test := range tests {
+		if v := test.formatter.FormatDuration(test.offset); v != test.expected {
+			t.Errorf("expected '%s' for %v but got '%s'", test.expected, test.offset, v)
+		}
+	}
+}
+
+func TestRelativeTimeFormatterUnits(t *testing.T) {
+	formatter := i18n.NewRelativeTimeFormatter("es")
+
+	if v := formatter.Format(1, i18n.TimeUnitDay); v != "mañana" {
+		t.Errorf("expected 'mañana' but got '%s'", v)
+	}
+	if v := formatter.Format(-1, i18n.TimeUnitYear); v != "el año pasado" {
+		t.Errorf("expected 'el año pasado' but got '%s'", v)
+	}
+	if v := formatter.Format(1.5, i18n.TimeUnitHour); v != "dentro de 1,5 horas" {
+		t.Errorf("expected 'dentro de 1,5 horas' but got '%s'", v)
+	}
+
+	now := time.Date(2024, time.March, 5, 14, 0, 0, 0, time.UTC)
+	formatter = i18n.NewRelativeTimeFormatter("en").WithNow(func() time.Time { return now })
+	if v := formatter.FormatTime(now.Add(-10 * time.Second)); v != "10 seconds ago" {
+		t.Errorf("expected '10 seconds ago' but got '%s'", v)
+	}
+}
+
+func TestReaderFormatRelativeTime(t *testing.T) {
+	catalogReader := i18n.NewCatalogReader().WithCatalog(i18n.NewCatalog()).WithLocale("fr")
+
+	if v := catalogReader.FormatRelativeTime(time.Now().Add(-3*time.Hour - time.Second)); v != "il y a 3 heures" {
+		t.Errorf("expected 'il y a 3 heures' but got '%s'", v)
+	}
+	if v := catalogReader.FormatDuration(90 * time.Minute); v != "1 heure et 30 minutes" {
+		t.Errorf("expected '1 heure et 30 minutes' but got '%s'", v)
+	}
+}
@@ -0,0 +1,241 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+)
+
+var (
+	ErrTemplateNotFound = errors.New("template not found")
+
+	errNoTemplateBundle = errors.New("template bundle is nil")
+	errNoTemplateFS     = errors.New("template bundle file system is nil")
+)
+
+// templateExecutor is implemented by both text/template and html/template templates
+type templateExecutor interface {
+	Execute(w io.Writer, data any) error
+}
+
+var _templateLocaleRegex = regexp.MustCompile(`^[a-zA-Z]{2,3}([-_][a-zA-Z0-9]{2,8})*$`)
+
+// TemplateBundle holds localized templates named '<name>.<locale>.<ext>', such as 'welcome.fr.html', resolving the
+// template of a locale with the fallback chain of its catalog; '<name>.<ext>' templates apply to every locale
+type TemplateBundle struct {
+	fsys    fs.FS
+	catalog *Catalog
+	funcs   map[string]any
+
+	files     map[string]map[string]string // '<name>.<ext>' -> normalized locale -> path
+	templates *sync.Map                    // '<locale>/<name>.<ext>' -> templateExecutor
+
+	lock sync.RWMutex
+}
+
+// NewTemplateBundle returns a new TemplateBundle loading the templates of the specified file system
+func NewTemplateBundle(fsys fs.FS) *TemplateBundle {
+	return &TemplateBundle{
+		fsys:      fsys,
+		funcs:     make(map[string]any),
+		files:     make(map[string]map[string]string),
+		templates: &sync.Map{},
+	}
+}
+
+// NewTemplateBundleFromDirectory returns a new TemplateBundle loading the templates of the specified directory
+func NewTemplateBundleFromDirectory(directory string) *TemplateBundle {
+	return NewTemplateBundle(os.DirFS(directory))
+}
+
+// WithCatalog sets the catalog providing the fallback chain of locales and the translation functions of templates
+func (tb *TemplateBundle) WithCatalog(catalog *Catalog) *TemplateBundle {
+	if tb != nil {
+		tb.catalog = catalog
+	}
+	return tb
+}
+
+// WithFuncs adds functions available to every template, besides the functions of CatalogReader.TextFuncMap
+func (tb *TemplateBundle) WithFuncs(funcs map[string]any) *TemplateBundle {
+	if tb != nil {
+		for name, f := range funcs {
+			tb.funcs[name] = f
+		}
+	}
+	return tb
+}
+
+// Initialize discovers the templates of the bundle file system; templates are parsed on first use for each locale
+func (tb *TemplateBundle) Initialize() (*TemplateBundle, error) {
+	switch {
+	case tb == nil:
+		return nil, errNoTemplateBundle
+	case tb.fsys == nil:
+		return tb, errNoTemplateFS
+	}
+
+	files := make(map[string]map[string]string)
+	err := fs.WalkDir(tb.fsys, ".", func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		name, locale := tb.templateNameAndLocale(filePath)
+		if files[name] == nil {
+			files[name] = make(map[string]string)
+		}
+		files[name][locale] = filePath
+		return nil
+	})
+	if err != nil {
+		return tb, fmt.Errorf("failed to discover templates: %w", err)
+	}
+
+	tb.lock.Lock()
+	defer tb.lock.Unlock()
+
+	tb.files = files
+	tb.templates = &sync.Map{}
+	return tb, nil
+}
+
+// templateNameAndLocale returns the name of the template file without its locale, and its normalized locale, e.g.
+// 'emails/welcome.html' and 'fr_CA' for 'emails/welcome.fr-CA.html'; the infix is a locale when its language has
+// CLDR data or when it is a locale of the catalog, so that 'email.txt.tmpl' or 'app.min.js' have no locale
+func (tb *TemplateBundle) templateNameAndLocale(filePath string) (string, string) {
+	directory, file := path.Split(filePath)
+
+	tokens := strings.Split(file, ".")
+	if len(tokens) < 3 || !tb.isLocale(tokens[len(tokens)-2]) {
+		return filePath, ""
+	}
+
+	locale := tokens[len(tokens)-2]
+	name := strings.Join(append(tokens[:len(tokens)-2], tokens[len(tokens)-1]), ".")
+	return directory + name, normalizeLocale(locale)
+}
+
+// isLocale returns whether the file name infix is a locale
+func (tb *TemplateBundle) isLocale(infix string) bool {
+	if !_templateLocaleRegex.MatchString(infix) {
+		return false
+	}
+	if knownLanguage(infix) {
+		return true
+	}
+
+	for _, locale := range tb.catalog.LoadedLocales() {
+		if normalizeLocale(locale) == normalizeLocale(infix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Names returns the sorted names of the templates of the bundle, e.g. 'welcome.html'
+func (tb *TemplateBundle) Names() []string {
+	if tb == nil {
+		return []string{}
+	}
+
+	tb.lock.RLock()
+	defer tb.lock.RUnlock()
+
+	names := make([]string, 0, len(tb.files))
+	for name := range tb.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Resolve returns the path of the template file used for the locale, searching the locales of the catalog fallback
+// chain and then the template without locale
+func (tb *TemplateBundle) Resolve(locale string, name string) (string, bool) {
+	if tb == nil {
+		return "", false
+	}
+
+	tb.lock.RLock()
+	defer tb.lock.RUnlock()
+
+	files := tb.files[name]
+	if len(files) == 0 {
+		return "", false
+	}
+
+	chain := []string{locale}
+	if tb.catalog != nil {
+		chain = tb.catalog.fallbackChain(locale)
+	}
+	for _, candidate := range append(chain, "") {
+		if filePath, exists := files[normalizeLocale(candidate)]; exists {
+			return filePath, true
+		}
+	}
+	return "", false
+}
+
+// Execute renders the template of the specified name for the locale, with the functions of a catalog reader of the
+// locale; the template is parsed on first use and cached for the locale
+func (tb *TemplateBundle) Execute(w io.Writer, locale string, name string, data any) error {
+	if tb == nil {
+		return errNoTemplateBundle
+	}
+
+	tb.lock.RLock()
+	templates := tb.templates
+	tb.lock.RUnlock()
+
+	cacheKey := locale + "/" + name
+	if tmpl, exists := templates.Load(cacheKey); exists {
+		return tmpl.(templateExecutor).Execute(w, data)
+	}
+
+	filePath, exists := tb.Resolve(locale, name)
+	if !exists {
+		return fmt.Errorf("%w: '%s' for locale '%s'", ErrTemplateNotFound, name, locale)
+	}
+
+	tmpl, err := tb.parse(locale, filePath)
+	if err != nil {
+		return err
+	}
+
+	cached, _ := templates.LoadOrStore(cacheKey, tmpl)
+	return cached.(templateExecutor).Execute(w, data)
+}
+
+// parse parses the template file with the template functions of a catalog reader of the locale, using html/template
+// for '.html' and '.htm' files and text/template otherwise
+func (tb *TemplateBundle) parse(locale string, filePath string) (templateExecutor, error) {
+	content, err := fs.ReadFile(tb.fsys, filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template '%s': %w", filePath, err)
+	}
+
+	reader := NewCatalogReader().WithCatalog(tb.catalog).WithLocale(locale)
+
+	var tmpl templateExecutor
+	switch path.Ext(filePath) {
+	case ".html", ".htm":
+		tmpl, err = htmltemplate.New(path.Base(filePath)).Funcs(reader.HTMLFuncMap()).Funcs(tb.funcs).Parse(string(content))
+	default:
+		tmpl, err = texttemplate.New(path.Base(filePath)).Funcs(reader.TextFuncMap()).Funcs(tb.funcs).Parse(string(content))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template '%s': %w", filePath, err)
+	}
+	return tmpl, nil
+}
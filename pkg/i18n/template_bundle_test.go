@@ -0,0 +1,111 @@
+package i18n_test
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func newTestTemplateBundle(t *testing.T) *i18n.TemplateBundle {
+	catalog := i18n.NewCatalog().WithDefaultLocale("en").WithRegistry(i18n.NewLocaleRegistry().Register(
+		i18n.LocaleInfo{Locale: "fr-CA", Parent: "fr"},
+	))
+	catalog.AddKeyValue("en", i18n.NewKeyPair("greeting", "Hello <b>{name}</b>"))
+	catalog.AddKeyValue("fr", i18n.NewKeyPair("greeting", "Bonjour <b>{name}</b>"))
+
+	fsys := fstest.MapFS{
+		"welcome.en.html":        {Data: []byte(`<p>{{ t "greeting" (args "name" .Name) }}</p>`)},
+		"welcome.fr.html":        {Data: []byte(`<p lang="{{ locale }}">{{ t "greeting" (args "name" .Name) }}</p>`)},
+		"emails/reset.txt":       {Data: []byte(`{{ upper .Name }}: {{ t "greeting" (args "name" .Name) }}`)},
+		"emails/reset.de-AT.txt": {Data: []byte(`Servus {{ .Name }}`)},
+		"report.final.txt":       {Data: []byte(`{{ shout .Name }}`)},
+		"email.txt.tmpl":         {Data: []byte(`{{ .Name }}`)},
+		"app.min.js":             {Data: []byte(`var name = "{{ .Name }}";`)},
+	}
+
+	bundle, err := i18n.NewTemplateBundle(fsys).WithCatalog(catalog).WithFuncs(map[string]any{
+		"shout": func(s string) string { return s + "!" },
+	}).Initialize()
+	if err != nil {
+		t.Fatalf("unexpected error initializing bundle; %v", err)
+	}
+	return bundle
+}
+
+func TestTemplateBundleExecute(t *testing.T) {
+	bundle := newTestTemplateBundle(t)
+
+	tests := []struct {
+		locale   string
+		name     string
+		expected string
+	}{
+		{"en", "welcome.html", "<p>Hello <b>&lt;Bob&gt;</b></p>"},
+		{"fr", "welcome.html", `<p lang="fr">Bonjour <b>&lt;Bob&gt;</b></p>`},
+		{"fr-CA", "welcome.html", `<p lang="fr-CA">Bonjour <b>&lt;Bob&gt;</b></p>`},
+		{"de", "welcome.html", "<p>Hello <b>&lt;Bob&gt;</b></p>"},
+		{"de_AT", "emails/reset.txt", "Servus <Bob>"},
+		{"de", "emails/reset.txt", "<BOB>: Hello <b><Bob></b>"},
+		{"en", "report.final.txt", "<Bob>!"},
+		{"fr", "email.txt.tmpl", "<Bob>"},
+		{"fr", "app.min.js", `var name = "<Bob>";`},
+	}
+
+	for _, test := range tests {
+		for i := 0; i < 2; i++ {
+			var sb strings.Builder
+			if err := bundle.Execute(&sb, test.locale, test.name, map[string]any{"Name": "<Bob>"}); err != nil {
+				t.Fatalf("unexpected error executing '%s' for '%s'; %v", test.name, test.locale, err)
+			}
+			if sb.String() != test.expected {
+				t.Errorf("expected '%s' for '%s' in '%s' but got '%s'", test.expected, test.name, test.locale, sb.String())
+			}
+		}
+	}
+
+	if err := bundle.Execute(&strings.Builder{}, "en", "missing.html", nil); !errors.Is(err, i18n.ErrTemplateNotFound) {
+		t.Errorf("expected ErrTemplateNotFound but got %v", err)
+	}
+}
+
+func TestTemplateBundleNames(t *testing.T) {
+	bundle := newTestTemplateBundle(t)
+
+	expected := "app.min.js,email.txt.tmpl,emails/reset.txt,report.final.txt,welcome.html"
+	if v := strings.Join(bundle.Names(), ","); v != expected {
+		t.Errorf("expected '%s' but got '%s'", expected, v)
+	}
+
+	if v, _ := bundle.Resolve("fr-CA", "welcome.html"); v != "welcome.fr.html" {
+		t.Errorf("expected 'welcome.fr.html' but got '%s'", v)
+	}
+	if _, exists := bundle.Resolve("en", "reset.txt"); exists {
+		t.Error("expected no template for 'reset.txt'")
+	}
+}
+
+func TestTemplateBundleConcurrentInitialize(t *testing.T) {
+	bundle := newTestTemplateBundle(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if err := bundle.Execute(&strings.Builder{}, "fr", "welcome.html", map[string]any{"Name": "Bob"}); err != nil {
+				t.Errorf("unexpected error executing template; %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := bundle.Initialize(); err != nil {
+				t.Errorf("unexpected error initializing bundle; %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,137 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+	"time"
+)
+
+var errInvalidTemplateArgs = errors.New("invalid template arguments")
+
+// TextFuncMap returns text/template functions bound to the reader:
+//
+//   - 't' formats a key with arguments like Format, e.g. {{ t "greeting" (args "name" .Name) }}
+//   - 'message' formats a key as an ICU MessageFormat message like FormatMessage
+//   - 'plural' formats the plural variant of a key for a count, passed as the localized '{0}' placeholder
+//   - 'number', 'currency', 'date', 'time' and 'list' format values for the reader locale
+//   - 'upper', 'lower', 'title' and 'sentence' convert the case of text for the reader locale
+//   - 'args' builds named arguments from name and value pairs
+//   - 'locale' and 'dir' return the reader locale and its direction ('ltr' or 'rtl')
+func (cr *CatalogReader) TextFuncMap() texttemplate.FuncMap {
+	funcs := cr.templateFuncs()
+	funcs["t"] = func(key string, args ...any) (string, error) {
+		return cr.formatValue(cr.Get(key), cr.formatArg, args...)
+	}
+	funcs["message"] = func(key string, args ...any) (string, error) {
+		return cr.formatMessageValue(cr.Get(key), cr.formatArg, args...)
+	}
+	funcs["plural"] = func(key string, count any, args ...any) (string, error) {
+		return cr.formatValue(cr.Plural(key, count), cr.formatArg, append([]any{cr.FormatNumber(count)}, args...)...)
+	}
+	return funcs
+}
+
+// HTMLFuncMap returns the html/template functions of TextFuncMap; catalog values are trusted HTML markup while the
+// arguments they interpolate are escaped, unless they are htmltemplate.HTML values; the values of missing keys, e.g.
+// '[unknown key:...]', are not trusted and are escaped
+func (cr *CatalogReader) HTMLFuncMap() htmltemplate.FuncMap {
+	funcs := cr.templateFuncs()
+	funcs["t"] = func(key string, args ...any) (htmltemplate.HTML, error) {
+		keyValue, found := cr.resolve(key)
+		return cr.formatHTML(keyValue, found, cr.formatValue, args...)
+	}
+	funcs["message"] = func(key string, args ...any) (htmltemplate.HTML, error) {
+		keyValue, found := cr.resolve(key)
+		return cr.formatHTML(keyValue, found, cr.formatMessageValue, args...)
+	}
+	funcs["plural"] = func(key string, count any, args ...any) (htmltemplate.HTML, error) {
+		keyValue, found := cr.resolvePluralVariant(key, func(locale string) PluralCategory {
+			return CardinalRules(locale).Select(count)
+		})
+		return cr.formatHTML(keyValue, found, cr.formatValue, append([]any{cr.FormatNumber(count)}, args...)...)
+	}
+	return funcs
+}
+
+// formatHTML formats the value with the arguments as HTML; values found in the catalog are trusted markup whose
+// arguments are escaped, while other values, such as missing key placeholders, are escaped entirely
+func (cr *CatalogReader) formatHTML(keyValue KeyValue, found bool, format func(KeyValue, func(...any) string, ...any) (string, error), args ...any) (htmltemplate.HTML, error) {
+	if !found {
+		value, err := format(keyValue, cr.formatArg, args...)
+		return htmltemplate.HTML(htmltemplate.HTMLEscapeString(value)), err
+	}
+
+	value, err := format(keyValue, cr.formatHTMLArg, args...)
+	return htmltemplate.HTML(value), err
+}
+
+// formatHTMLArg returns the template argument as escaped HTML isolated for the reader direction, keeping
+// htmltemplate.HTML arguments as is
+func (cr *CatalogReader) formatHTMLArg(args ...any) string {
+	if len(args) == 1 {
+		if html, ok := args[0].(htmltemplate.HTML); ok {
+			return cr.isolate(string(html))
+		}
+	}
+	return cr.isolate(htmltemplate.HTMLEscapeString(fmt.Sprint(args...)))
+}
+
+// templateFuncs returns the template functions that do not depend on the template package
+func (cr *CatalogReader) templateFuncs() map[string]any {
+	locale := cr.Locale()
+	funcs := map[string]any{
+		"number":   cr.FormatNumber,
+		"currency": cr.FormatCurrency,
+		"date": func(t time.Time, style ...string) string {
+			return formatMessageTime(locale, t, "date", firstOrEmpty(style))
+		},
+		"time": func(t time.Time, style ...string) string {
+			return formatMessageTime(locale, t, "time", firstOrEmpty(style))
+		},
+		"list": func(items any) (string, error) {
+			switch list := items.(type) {
+			case []string:
+				return cr.FormatList(list), nil
+			case []any:
+				values := make([]string, len(list))
+				for i, item := range list {
+					values[i] = fmt.Sprint(item)
+				}
+				return cr.FormatList(values), nil
+			default:
+				return "", fmt.Errorf("%w: 'list' expects a slice; found %T", errInvalidTemplateArgs, items)
+			}
+		},
+		"args": func(pairs ...any) (Args, error) {
+			if len(pairs)%2 != 0 {
+				return nil, fmt.Errorf("%w: 'args' expects name and value pairs", errInvalidTemplateArgs)
+			}
+			args := Args{}
+			for i := 0; i < len(pairs); i += 2 {
+				name, ok := pairs[i].(string)
+				if !ok {
+					return nil, fmt.Errorf("%w: 'args' expects string names; found %T", errInvalidTemplateArgs, pairs[i])
+				}
+				args[name] = pairs[i+1]
+			}
+			return args, nil
+		},
+		"locale": func() string { return locale },
+		"dir":    func() string { return cr.Direction().String() },
+	}
+
+	for name, f := range cr.CaseFuncs() {
+		funcs[name] = f
+	}
+	return funcs
+}
+
+// firstOrEmpty returns the first value, or an empty string if there is none
+func firstOrEmpty(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,78 @@
+package i18n_test
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	texttemplate "text/template"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func newTemplateTestReader() *i18n.CatalogReader {
+	catalog := i18n.NewCatalog()
+	catalog.AddKeyValue("en", i18n.NewKeyPair("greeting", "Hello <b>{name}</b>"))
+	catalog.AddKeyValue("en", i18n.NewKeyPair("files[one]", "{0} file in {1}"))
+	catalog.AddKeyValue("en", i18n.NewKeyPair("files[other]", "{0} files in {1}"))
+	catalog.AddKeyValue("en", i18n.NewKeyPair("invites", "{count, plural, one {# invite} other {# invites}} from {name}"))
+
+	return i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("en")
+}
+
+func TestTextFuncMap(t *testing.T) {
+	source := `{{ t "greeting" (args "name" .Name) }}|{{ plural "files" 1200 .Folder }}|{{ message "invites" (args "count" 2 "name" .Name) }}|` +
+		`{{ number 1234.5 }}|{{ currency 5 "EUR" }}|{{ date .Date "long" }}|{{ list .Items }}|{{ upper "title" }}|{{ dir }}`
+
+	tmpl, err := texttemplate.New("text").Funcs(newTemplateTestReader().TextFuncMap()).Parse(source)
+	if err != nil {
+		t.Fatalf("unexpected error parsing template; %v", err)
+	}
+
+	var sb strings.Builder
+	data := map[string]any{"Name": "<Bob>", "Folder": "docs", "Date": testTime, "Items": []string{"a", "b", "c"}}
+	if err := tmpl.Execute(&sb, data); err != nil {
+		t.Fatalf("unexpected error executing template; %v", err)
+	}
+
+	expected := "Hello <b><Bob></b>|1,200 files in docs|2 invites from <Bob>|1,234.5|€5.00|March 5, 2024|a, b, and c|TITLE|ltr"
+	if sb.String() != expected {
+		t.Errorf("expected '%s' but got '%s'", expected, sb.String())
+	}
+}
+
+func TestHTMLFuncMap(t *testing.T) {
+	source := `<p title="{{ t "greeting" (args "name" .Name) }}">{{ t "greeting" (args "name" .Name) }}</p>` +
+		`<p>{{ t "greeting" (args "name" .Trusted) }}</p><p>{{ message "invites" (args "count" 1 "name" .Name) }}</p>`
+
+	tmpl, err := htmltemplate.New("html").Funcs(newTemplateTestReader().HTMLFuncMap()).Parse(source)
+	if err != nil {
+		t.Fatalf("unexpected error parsing template; %v", err)
+	}
+
+	var sb strings.Builder
+	data := map[string]any{"Name": "<Bob>", "Trusted": htmltemplate.HTML("<i>Bob</i>")}
+	if err := tmpl.Execute(&sb, data); err != nil {
+		t.Fatalf("unexpected error executing template; %v", err)
+	}
+
+	expected := `<p title="Hello &lt;Bob&gt;">Hello <b>&lt;Bob&gt;</b></p><p>Hello <b><i>Bob</i></b></p><p>1 invite from &lt;Bob&gt;</p>`
+	if sb.String() != expected {
+		t.Errorf("expected '%s' but got '%s'", expected, sb.String())
+	}
+
+	sb.Reset()
+	tmpl = htmltemplate.Must(htmltemplate.New("missing").Funcs(newTemplateTestReader().HTMLFuncMap()).Parse(
+		`<p>{{ t .Key }}</p><p>{{ message .Key }}</p><p>{{ plural .Key 2 }}</p>`))
+	if err := tmpl.Execute(&sb, map[string]any{"Key": "missing<x>"}); err != nil {
+		t.Fatalf("unexpected error executing template; %v", err)
+	}
+	missing := "<p>[unknown key:missing&lt;x&gt;]</p>"
+	if expected := strings.Repeat(missing, 3); sb.String() != expected {
+		t.Errorf("expected '%s' but got '%s'", expected, sb.String())
+	}
+
+	tmpl = htmltemplate.Must(htmltemplate.New("args").Funcs(newTemplateTestReader().HTMLFuncMap()).Parse(`{{ args "name" }}`))
+	if err := tmpl.Execute(&sb, nil); err == nil {
+		t.Error("expected an error for odd 'args' arguments")
+	}
+}
@@ -0,0 +1,142 @@
+package i18n
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	decimalByteUnits = []string{"byte", "kilobyte", "megabyte", "gigabyte", "terabyte", "petabyte"}
+	binaryByteUnits  = []string{"byte", "kibibyte", "mebibyte", "gibibyte", "tebibyte", "pebibyte"}
+)
+
+//go:embed data/units.txt
+var unitsData string
+
+var (
+	unitsOnce        sync.Once
+	unitPatternsData map[string]map[PluralCategory]string // '<locale>/<width>/<unit>' -> category -> pattern
+)
+
+func loadUnitPatterns() {
+	unitPatternsData = make(map[string]map[PluralCategory]string)
+
+	scanner := bufio.NewScanner(strings.NewReader(unitsData))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := _numberDataRegex.FindAllString(line, -1)
+		if len(fields) < 5 || len(fields)%2 != 1 {
+			panic(fmt.Sprintf("i18n: invalid unit data line '%s'", line))
+		}
+
+		patterns := make(map[PluralCategory]string)
+		for i := 3; i < len(fields); i += 2 {
+			pattern, err := strconv.Unquote(fields[i+1])
+			if err != nil {
+				panic(fmt.Sprintf("i18n: invalid unit data field '%s': %v", fields[i+1], err))
+			}
+			patterns[PluralCategory(fields[i])] = pattern
+		}
+
+		unitPatternsData[fields[0]+"/"+fields[1]+"/"+fields[2]] = patterns
+	}
+}
+
+// unitPatterns returns the plural patterns of the unit for the locale and width, falling back from narrow and long
+// to short for each locale, then from the locale to its parents and finally to 'root'
+func unitPatterns(locale string, width Width, unit string) map[PluralCategory]string {
+	unitsOnce.Do(loadUnitPatterns)
+
+	widths := []Width{width}
+	if width != WidthShort {
+		widths = append(widths, WidthShort)
+	}
+
+	for _, candidate := range append(localeCandidates(locale), "root") {
+		for _, w := range widths {
+			if patterns, exists := unitPatternsData[candidate+"/"+w.String()+"/"+unit]; exists {
+				return patterns
+			}
+		}
+	}
+	return nil
+}
+
+// formatUnitPattern places the formatted number into the unit pattern selected by the plural category of display,
+// the unformatted ASCII representation of the number
+func formatUnitPattern(locale string, width Width, unit string, number string, display string) string {
+	patterns := unitPatterns(locale, width, unit)
+	if patterns == nil {
+		return number + " " + unit
+	}
+
+	pattern, exists := patterns[CardinalRules(locale).Select(display)]
+	if !exists {
+		pattern = patterns[PluralOther]
+	}
+	return strings.ReplaceAll(pattern, "{0}", number)
+}
+
+// FormatUnit returns the value formatted with the localized pattern of the CLDR unit (e.g. 'kilobyte' or 'hour')
+// using the formatter width
+func (nf *NumberFormatter) FormatUnit(value any, unit string) string {
+	if nf == nil {
+		return fmt.Sprint(value) + " " + unit
+	}
+
+	decimal := *nf
+	decimal.style = NumberDecimal
+	number := decimal.Format(value)
+
+	f, _ := messageNumber(value)
+	_, maximumFraction := nf.fractionDigits(nf.symbols.decimalPattern.minimumFraction, nf.symbols.decimalPattern.maximumFraction)
+	return formatUnitPattern(nf.locale, nf.width, unit, number, asciiDecimal(f, maximumFraction))
+}
+
+// asciiDecimal returns the value rounded to the maximum fraction digits without trailing zeros
+func asciiDecimal(value float64, maximumFraction int) string {
+	display := strconv.FormatFloat(math.Abs(value), 'f', maximumFraction, 64)
+	if strings.Contains(display, ".") {
+		display = strings.TrimRight(strings.TrimRight(display, "0"), ".")
+	}
+	return display
+}
+
+// formatByteSize formats a number of bytes using the largest unit of the base that keeps the value at least 1
+func (nf *NumberFormatter) formatByteSize(value float64, base float64, units []string) string {
+	abs := math.Abs(value)
+
+	index := 0
+	for abs >= base && index < len(units)-1 {
+		abs /= base
+		index++
+	}
+
+	maximumFraction := 0
+	if index > 0 {
+		maximumFraction = nf.compactFractionDigits(abs)
+	}
+
+	if rounded, _ := strconv.ParseFloat(strconv.FormatFloat(abs, 'f', maximumFraction, 64), 64); rounded >= base && index < len(units)-1 {
+		abs /= base
+		index++
+		maximumFraction = nf.compactFractionDigits(abs)
+	}
+
+	minimumFraction, _ := nf.fractionDigits(0, maximumFraction)
+	number, zero := nf.formatDecimal(abs, nf.symbols.decimalPattern, minimumFraction, maximumFraction)
+	if value < 0 && !zero {
+		number = nf.symbols.minus + number
+	}
+
+	return formatUnitPattern(nf.locale, nf.width, units[index], number, asciiDecimal(abs, maximumFraction))
+}
@@ -0,0 +1,130 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SelectOther is the mandatory fallback selector of every key variant
+const SelectOther = "other"
+
+var ErrMissingOtherVariant = errors.New("missing 'other' variant")
+
+// VariantKey returns the catalog key used for the variant of the specified key, e.g. 'greeting[female]' or
+// 'notify[female][admin]'
+func VariantKey(key string, selectors ...string) string {
+	var sb strings.Builder
+	sb.WriteString(key)
+	for _, selector := range selectors {
+		sb.WriteString("[" + selector + "]")
+	}
+	return sb.String()
+}
+
+// splitVariantKey returns the base key and selectors of a variant key, or the key and no selectors if it is not one
+func splitVariantKey(key string) (string, []string) {
+	index := strings.IndexByte(key, '[')
+	if index <= 0 || !strings.HasSuffix(key, "]") {
+		return key, nil
+	}
+
+	selectors := strings.Split(key[index+1:len(key)-1], "][")
+	for _, selector := range selectors {
+		if selector == "" || strings.ContainsAny(selector, "[]") {
+			return key, nil
+		}
+	}
+	return key[:index], selectors
+}
+
+// variantCandidates returns the variant keys to try for the selectors, most specific first; each selector falls back
+// to SelectOther, with earlier selectors taking precedence, and finally the key itself; empty selectors and selectors
+// containing brackets, which cannot be part of a variant key, are SelectOther
+func variantCandidates(key string, selectors []string) []string {
+	selectors = sanitizeSelectors(selectors)
+
+	candidates := [][]string{selectors}
+	for i := len(selectors) - 1; i >= 0; i-- {
+		if selectors[i] == SelectOther {
+			continue
+		}
+
+		for _, candidate := range candidates {
+			replaced := append([]string{}, candidate...)
+			replaced[i] = SelectOther
+			candidates = append(candidates, replaced)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return variantRank(candidates[i]) < variantRank(candidates[j])
+	})
+
+	keys := make([]string, 0, len(candidates)+1)
+	for _, candidate := range candidates {
+		keys = append(keys, VariantKey(key, candidate...))
+	}
+	return append(keys, key)
+}
+
+// sanitizeSelectors returns a copy of the selectors with the empty selectors and the selectors containing brackets
+// replaced by SelectOther
+func sanitizeSelectors(selectors []string) []string {
+	sanitized := make([]string, len(selectors))
+	for i, selector := range selectors {
+		if selector == "" || strings.ContainsAny(selector, "[]") {
+			selector = SelectOther
+		}
+		sanitized[i] = selector
+	}
+	return sanitized
+}
+
+// variantRank orders variant selectors so that selectors other than SelectOther on the left are preferred
+func variantRank(selectors []string) string {
+	var sb strings.Builder
+	for _, selector := range selectors {
+		if selector == SelectOther {
+			sb.WriteByte('1')
+		} else {
+			sb.WriteByte('0')
+		}
+	}
+	return sb.String()
+}
+
+// ValidateVariants is a LocaleValidator that requires every key with variants to define the SelectOther variant,
+// e.g. 'greeting[other]' when 'greeting[female]' exists
+func ValidateVariants(locale string, keyValues map[string]KeyValue) error {
+	missing := make(map[string]bool)
+	for key := range keyValues {
+		base, selectors := splitVariantKey(key)
+		if len(selectors) == 0 {
+			continue
+		}
+
+		others := make([]string, len(selectors))
+		for i := range others {
+			others[i] = SelectOther
+		}
+
+		otherKey := VariantKey(base, others...)
+		if _, exists := keyValues[otherKey]; !exists {
+			missing[otherKey] = true
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(missing))
+	for key := range missing {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return fmt.Errorf("%w: %s", ErrMissingOtherVariant, strings.Join(keys, ", "))
+}
@@ -0,0 +1,63 @@
+package i18n_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bjusten/go-i18n/pkg/i18n"
+)
+
+func TestReaderSelect(t *testing.T) {
+	parser := testParser{
+		i18n.NewKeyPair("invited[female]", "She invited you"),
+		i18n.NewKeyPair("invited[male]", "He invited you"),
+		i18n.NewKeyPair("invited[other]", "They invited you"),
+		i18n.NewKeyPair("notify[female][admin]", "She (admin) updated the page"),
+		i18n.NewKeyPair("notify[female][other]", "She updated the page"),
+		i18n.NewKeyPair("notify[other][admin]", "An admin updated the page"),
+		i18n.NewKeyPair("notify[other][other]", "Someone updated the page"),
+	}
+
+	catalog, err := i18n.NewCatalog().WithParser(parser).WithLocaleValidators(i18n.ValidateVariants).Initialize()
+	if err != nil {
+		t.Fatalf("unexpected error initializing catalog; %v", err)
+	}
+
+	catalogReader := i18n.NewCatalogReader().WithCatalog(catalog).WithLocale("test")
+
+	tests := []struct {
+		key       string
+		selectors []string
+		expected  string
+	}{
+		{"invited", []string{"female"}, "She invited you"},
+		{"invited", []string{"unknown"}, "They invited you"},
+		{"notify", []string{"female", "admin"}, "She (admin) updated the page"},
+		{"notify", []string{"female", "editor"}, "She updated the page"},
+		{"notify", []string{"male", "admin"}, "An admin updated the page"},
+		{"notify", []string{"male", "editor"}, "Someone updated the page"},
+		{"invited", []string{""}, "They invited you"},
+		{"invited", []string{"a]b"}, "They invited you"},
+		{"invited", []string{"[female]"}, "They invited you"},
+		{"notify", []string{"female", ""}, "She updated the page"},
+		{"notify", []string{"", "admin"}, "An admin updated the page"},
+	}
+
+	for _, test := range tests {
+		if v := catalogReader.Select(test.key, test.selectors...); v.Value() != test.expected {
+			t.Errorf("expected '%s' for %v but got '%s'", test.expected, test.selectors, v.Value())
+		}
+	}
+}
+
+func TestValidateVariants(t *testing.T) {
+	parser := testParser{
+		i18n.NewKeyPair("invited[female]", "She invited you"),
+		i18n.NewKeyPair("invited[male]", "He invited you"),
+	}
+
+	_, err := i18n.NewCatalog().WithParser(parser).WithLocaleValidators(i18n.ValidateVariants).Initialize()
+	if !errors.Is(err, i18n.ErrMissingOtherVariant) {
+		t.Errorf("expected missing other variant error but got %v", err)
+	}
+}